@@ -0,0 +1,91 @@
+package goshopify
+
+import (
+	"fmt"
+	"strings"
+)
+
+const accessScopesBasePath = "admin/oauth/access_scopes"
+
+// AccessScopeService is an interface for interfacing with the access scope
+// endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/access/accessscope
+type AccessScopeService interface {
+	List(interface{}) ([]AccessScope, error)
+	Missing() ([]string, error)
+}
+
+// AccessScopeServiceOp handles communication with the access scope related
+// methods of the Shopify API.
+type AccessScopeServiceOp struct {
+	client *Client
+}
+
+// AccessScope represents a scope granted to the access token.
+type AccessScope struct {
+	Handle string `json:"handle"`
+}
+
+// AccessScopesResource represents the result from the
+// admin/oauth/access_scopes.json endpoint
+type AccessScopesResource struct {
+	AccessScopes []AccessScope `json:"access_scopes"`
+}
+
+// List the scopes granted to the access token
+func (s *AccessScopeServiceOp) List(options interface{}) ([]AccessScope, error) {
+	path := fmt.Sprintf("%s.json", accessScopesBasePath)
+	resource := new(AccessScopesResource)
+	err := s.client.Get(path, resource, options)
+	return resource.AccessScopes, err
+}
+
+// Missing returns the scopes required by the App.Scope of the client that
+// have not been granted to the access token. An empty result means the token
+// has every scope the app asks for, otherwise the shop needs to go through
+// the oauth flow again.
+func (s *AccessScopeServiceOp) Missing() ([]string, error) {
+	scopes, err := s.List(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	granted := make([]string, len(scopes))
+	for i, scope := range scopes {
+		granted[i] = scope.Handle
+	}
+	return MissingScopes(s.client.app.Scopes(), granted), nil
+}
+
+// Scopes returns the individual scopes in the comma separated App.Scope.
+func (app App) Scopes() []string {
+	var scopes []string
+	for _, scope := range strings.Split(app.Scope, ",") {
+		scope = strings.TrimSpace(scope)
+		if scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// MissingScopes returns the scopes in required that are not in granted. A
+// granted write_ scope also satisfies the corresponding read_ scope, since
+// Shopify implies the one from the other.
+func MissingScopes(required, granted []string) []string {
+	grantedSet := make(map[string]bool, len(granted))
+	for _, scope := range granted {
+		grantedSet[scope] = true
+		if strings.HasPrefix(scope, "write_") {
+			grantedSet["read_"+strings.TrimPrefix(scope, "write_")] = true
+		}
+	}
+
+	var missing []string
+	for _, scope := range required {
+		if !grantedSet[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,85 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAccessScopeList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/oauth/access_scopes.json",
+		httpmock.NewStringResponder(200, `{"access_scopes": [{"handle":"read_products"},{"handle":"write_orders"}]}`))
+
+	scopes, err := client.AccessScope.List(nil)
+	if err != nil {
+		t.Errorf("AccessScope.List returned error: %v", err)
+	}
+
+	expected := []AccessScope{{Handle: "read_products"}, {Handle: "write_orders"}}
+	if !reflect.DeepEqual(scopes, expected) {
+		t.Errorf("AccessScope.List returned %+v, expected %+v", scopes, expected)
+	}
+}
+
+func TestAccessScopeMissing(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.app.Scope = "read_products, read_orders,write_customers"
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/oauth/access_scopes.json",
+		httpmock.NewStringResponder(200, `{"access_scopes": [{"handle":"write_products"},{"handle":"read_customers"}]}`))
+
+	missing, err := client.AccessScope.Missing()
+	if err != nil {
+		t.Errorf("AccessScope.Missing returned error: %v", err)
+	}
+
+	expected := []string{"read_orders", "write_customers"}
+	if !reflect.DeepEqual(missing, expected) {
+		t.Errorf("AccessScope.Missing returned %+v, expected %+v", missing, expected)
+	}
+}
+
+func TestAppScopes(t *testing.T) {
+	cases := []struct {
+		scope    string
+		expected []string
+	}{
+		{"", nil},
+		{"read_products", []string{"read_products"}},
+		{"read_products, write_orders,", []string{"read_products", "write_orders"}},
+	}
+
+	for _, c := range cases {
+		actual := App{Scope: c.scope}.Scopes()
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("App.Scopes(): expected %+v, actual %+v", c.expected, actual)
+		}
+	}
+}
+
+func TestMissingScopes(t *testing.T) {
+	cases := []struct {
+		required []string
+		granted  []string
+		expected []string
+	}{
+		{[]string{"read_products"}, []string{"read_products"}, nil},
+		{[]string{"read_products"}, []string{"write_products"}, nil},
+		{[]string{"write_products"}, []string{"read_products"}, []string{"write_products"}},
+		{[]string{"read_products", "read_orders"}, nil, []string{"read_products", "read_orders"}},
+		{nil, []string{"read_products"}, nil},
+	}
+
+	for _, c := range cases {
+		actual := MissingScopes(c.required, c.granted)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("MissingScopes(%v, %v): expected %+v, actual %+v", c.required, c.granted, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package goshopify
+
+import "github.com/shopspring/decimal"
+
+const applicationCreditsBasePath = "admin/application_credits"
+
+// ApplicationCreditService is an interface for interacting with the
+// ApplicationCredit endpoints of the Shopify API.
+// See https://help.shopify.com/api/reference/billing/applicationcredit
+type ApplicationCreditService interface {
+	Create(ApplicationCredit) (*ApplicationCredit, error)
+	Get(int64, interface{}) (*ApplicationCredit, error)
+	List(interface{}) ([]ApplicationCredit, error)
+}
+
+// ApplicationCreditServiceOp handles communication with the
+// ApplicationCredit related methods of the Shopify API.
+type ApplicationCreditServiceOp struct {
+	client *Client
+}
+
+// ApplicationCredit represents a Shopify ApplicationCredit, an amount the
+// merchant can spend on future charges of the app. Test credits don't
+// affect the merchant's balance.
+type ApplicationCredit struct {
+	ID          int64            `json:"id,omitempty"`
+	Amount      *decimal.Decimal `json:"amount,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Test        *bool            `json:"test,omitempty"`
+}
+
+// applicationCredits returns the resource for the application credits
+// endpoints
+func (a ApplicationCreditServiceOp) applicationCredits() *Resource[ApplicationCredit] {
+	return NewResource[ApplicationCredit](a.client, applicationCreditsBasePath, "application_credit", "application_credits")
+}
+
+// Create creates new application credit.
+func (a ApplicationCreditServiceOp) Create(credit ApplicationCredit) (*ApplicationCredit, error) {
+	return a.applicationCredits().Create(credit)
+}
+
+// Get gets individual application credit.
+func (a ApplicationCreditServiceOp) Get(creditID int64, options interface{}) (*ApplicationCredit, error) {
+	return a.applicationCredits().Get(creditID, options)
+}
+
+// List gets all application credits.
+func (a ApplicationCreditServiceOp) List(options interface{}) ([]ApplicationCredit, error) {
+	return a.applicationCredits().List(options)
+}
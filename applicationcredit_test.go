@@ -0,0 +1,90 @@
+package goshopify
+
+import (
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func applicationCreditTests(t *testing.T, credit ApplicationCredit) {
+	expectedID := int64(445365009)
+	if credit.ID != expectedID {
+		t.Errorf("ApplicationCredit.ID returned %+v, expected %+v", credit.ID, expectedID)
+	}
+
+	expectedAmount := decimal.RequireFromString("5.00")
+	if credit.Amount == nil || !credit.Amount.Equals(expectedAmount) {
+		t.Errorf("ApplicationCredit.Amount returned %+v, expected %+v", credit.Amount, expectedAmount)
+	}
+
+	expectedDescription := "credit for application refund"
+	if credit.Description != expectedDescription {
+		t.Errorf("ApplicationCredit.Description returned %+v, expected %+v", credit.Description, expectedDescription)
+	}
+
+	if credit.Test == nil || !*credit.Test {
+		t.Errorf("ApplicationCredit.Test returned %+v, expected true", credit.Test)
+	}
+}
+
+func TestApplicationCreditServiceOp_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/application_credits.json",
+		bodyCheckingResponder(t,
+			`{"application_credit": {"amount": "5", "description": "credit for application refund", "test": true}}`,
+			201,
+			loadFixture("application_credit.json")))
+
+	test := true
+	credit := ApplicationCredit{
+		Amount:      decimalPtr("5"),
+		Description: "credit for application refund",
+		Test:        &test,
+	}
+
+	created, err := client.ApplicationCredit.Create(credit)
+	if err != nil {
+		t.Fatalf("ApplicationCredit.Create returned error: %v", err)
+	}
+
+	applicationCreditTests(t, *created)
+}
+
+func TestApplicationCreditServiceOp_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/application_credits/445365009.json",
+		httpmock.NewBytesResponder(200, loadFixture("application_credit.json")))
+
+	credit, err := client.ApplicationCredit.Get(445365009, nil)
+	if err != nil {
+		t.Fatalf("ApplicationCredit.Get returned error: %v", err)
+	}
+
+	applicationCreditTests(t, *credit)
+}
+
+func TestApplicationCreditServiceOp_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/application_credits.json",
+		httpmock.NewBytesResponder(200, loadFixture("application_credits.json")))
+
+	credits, err := client.ApplicationCredit.List(nil)
+	if err != nil {
+		t.Fatalf("ApplicationCredit.List returned error: %v", err)
+	}
+
+	if len(credits) != 2 {
+		t.Fatalf("ApplicationCredit.List returned %d credits, expected 2", len(credits))
+	}
+	applicationCreditTests(t, credits[0])
+	if credits[1].Test != nil {
+		t.Errorf("ApplicationCredit.Test returned %+v, expected nil", credits[1].Test)
+	}
+}
@@ -0,0 +1,164 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const articlesBasePath = "admin/articles"
+const articlesResourceName = "articles"
+
+// ArticleService is an interface for interfacing with the articles endpoints
+// of the Shopify API. Articles are the posts of a blog.
+// See: https://help.shopify.com/api/reference/online_store/article
+type ArticleService interface {
+	List(int64, interface{}) ([]Article, error)
+	Count(int64, interface{}) (int, error)
+	Get(int64, int64, interface{}) (*Article, error)
+	Create(int64, Article) (*Article, error)
+	Update(int64, Article) (*Article, error)
+	Delete(int64, int64) error
+	ListAuthors() ([]string, error)
+	ListTags(interface{}) ([]string, error)
+
+	// MetafieldsService used for Article resource to communicate with Metafields resource
+	MetafieldsService
+}
+
+// ArticleServiceOp handles communication with the article related methods of
+// the Shopify API.
+type ArticleServiceOp struct {
+	client *Client
+}
+
+// Article represents a Shopify blog article. Published is only sent to
+// publish or hide an article, Shopify returns PublishedAt instead.
+type Article struct {
+	ID                int64         `json:"id,omitempty"`
+	BlogID            int64         `json:"blog_id,omitempty"`
+	Title             string        `json:"title,omitempty"`
+	Handle            string        `json:"handle,omitempty"`
+	Author            string        `json:"author,omitempty"`
+	UserID            int64         `json:"user_id,omitempty"`
+	BodyHTML          string        `json:"body_html,omitempty"`
+	SummaryHTML       string        `json:"summary_html,omitempty"`
+	Tags              string        `json:"tags,omitempty"`
+	TemplateSuffix    string        `json:"template_suffix,omitempty"`
+	Image             *ArticleImage `json:"image,omitempty"`
+	Published         *bool         `json:"published,omitempty"`
+	PublishedAt       *time.Time    `json:"published_at,omitempty"`
+	CreatedAt         *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time    `json:"updated_at,omitempty"`
+	Metafields        []Metafield   `json:"metafields,omitempty"`
+	AdminGraphqlAPIID string        `json:"admin_graphql_api_id,omitempty"`
+}
+
+// ArticleImage is the featured image of an article. To upload an image, set
+// either its Src or its base64 encoded Attachment.
+type ArticleImage struct {
+	Src        string     `json:"src,omitempty"`
+	Alt        string     `json:"alt,omitempty"`
+	Attachment string     `json:"attachment,omitempty"`
+	Width      int        `json:"width,omitempty"`
+	Height     int        `json:"height,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+}
+
+// ArticleTagsOptions are the options of the article tags list. Popular
+// orders the tags by the number of articles using them.
+type ArticleTagsOptions struct {
+	Limit   int `url:"limit,omitempty"`
+	Popular int `url:"popular,omitempty"`
+}
+
+// articles returns the resource for the articles endpoints of a blog
+func (s *ArticleServiceOp) articles(blogID int64) *Resource[Article] {
+	path := fmt.Sprintf("%s/%d/articles", blogsBasePath, blogID)
+	return NewResource[Article](s.client, path, "article", "articles")
+}
+
+// List articles of a blog
+func (s *ArticleServiceOp) List(blogID int64, options interface{}) ([]Article, error) {
+	return s.articles(blogID).List(options)
+}
+
+// Count articles of a blog
+func (s *ArticleServiceOp) Count(blogID int64, options interface{}) (int, error) {
+	return s.articles(blogID).Count(options)
+}
+
+// Get individual article
+func (s *ArticleServiceOp) Get(blogID, articleID int64, options interface{}) (*Article, error) {
+	return s.articles(blogID).Get(articleID, options)
+}
+
+// Create a new article
+func (s *ArticleServiceOp) Create(blogID int64, article Article) (*Article, error) {
+	return s.articles(blogID).Create(article)
+}
+
+// Update an existing article
+func (s *ArticleServiceOp) Update(blogID int64, article Article) (*Article, error) {
+	return s.articles(blogID).Update(article.ID, article)
+}
+
+// Delete an existing article
+func (s *ArticleServiceOp) Delete(blogID, articleID int64) error {
+	return s.articles(blogID).Delete(articleID)
+}
+
+// ListAuthors lists the authors of the articles of all blogs
+func (s *ArticleServiceOp) ListAuthors() ([]string, error) {
+	path := fmt.Sprintf("%s/authors.json", articlesBasePath)
+	resource := new(struct {
+		Authors []string `json:"authors"`
+	})
+	err := s.client.Get(path, resource, nil)
+	return resource.Authors, err
+}
+
+// ListTags lists the tags of the articles of all blogs
+func (s *ArticleServiceOp) ListTags(options interface{}) ([]string, error) {
+	path := fmt.Sprintf("%s/tags.json", articlesBasePath)
+	resource := new(struct {
+		Tags []string `json:"tags"`
+	})
+	err := s.client.Get(path, resource, options)
+	return resource.Tags, err
+}
+
+// List metafields for an article
+func (s *ArticleServiceOp) ListMetafields(articleID int64, options interface{}) ([]Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.List(options)
+}
+
+// Count metafields for an article
+func (s *ArticleServiceOp) CountMetafields(articleID int64, options interface{}) (int, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Count(options)
+}
+
+// Get individual metafield for an article
+func (s *ArticleServiceOp) GetMetafield(articleID int64, metafieldID int64, options interface{}) (*Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Get(metafieldID, options)
+}
+
+// Create a new metafield for an article
+func (s *ArticleServiceOp) CreateMetafield(articleID int64, metafield Metafield) (*Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Create(metafield)
+}
+
+// Update an existing metafield for an article
+func (s *ArticleServiceOp) UpdateMetafield(articleID int64, metafield Metafield) (*Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Update(metafield)
+}
+
+// Delete an existing metafield for an article
+func (s *ArticleServiceOp) DeleteMetafield(articleID int64, metafieldID int64) error {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Delete(metafieldID)
+}
@@ -0,0 +1,309 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+)
+
+func articleTests(t *testing.T, article Article) {
+	// Check that the ID is assigned to the returned article
+	expectedID := int64(134645308)
+	if article.ID != expectedID {
+		t.Errorf("Article.ID returned %+v, expected %+v", article.ID, expectedID)
+	}
+
+	expectedBlogID := int64(241253187)
+	if article.BlogID != expectedBlogID {
+		t.Errorf("Article.BlogID returned %+v, expected %+v", article.BlogID, expectedBlogID)
+	}
+
+	expectedAuthor := "Dennis"
+	if article.Author != expectedAuthor {
+		t.Errorf("Article.Author returned %+v, expected %+v", article.Author, expectedAuthor)
+	}
+
+	if article.Image == nil || article.Image.Alt != "iPod" {
+		t.Errorf("Article.Image returned %+v, expected an image with alt %s", article.Image, "iPod")
+	}
+
+	if article.PublishedAt == nil {
+		t.Errorf("Article.PublishedAt returned %+v, expected a time", article.PublishedAt)
+	}
+}
+
+func TestArticleList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/blogs/241253187/articles.json",
+		httpmock.NewBytesResponder(200, loadFixture("articles.json")))
+
+	articles, err := client.Article.List(241253187, nil)
+	if err != nil {
+		t.Errorf("Article.List returned error: %v", err)
+	}
+
+	if len(articles) != 2 {
+		t.Fatalf("Article.List returned %d articles, expected 2", len(articles))
+	}
+	articleTests(t, articles[0])
+}
+
+func TestArticleCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/blogs/241253187/articles/count.json",
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.Article.Count(241253187, nil)
+	if err != nil {
+		t.Errorf("Article.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("Article.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestArticleGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/blogs/241253187/articles/134645308.json",
+		httpmock.NewBytesResponder(200, loadFixture("article.json")))
+
+	article, err := client.Article.Get(241253187, 134645308, nil)
+	if err != nil {
+		t.Errorf("Article.Get returned error: %v", err)
+	}
+
+	articleTests(t, *article)
+}
+
+func TestArticleCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/blogs/241253187/articles.json",
+		bodyCheckingResponder(t,
+			`{"article": {
+				"title": "get on the train now",
+				"author": "Dennis",
+				"body_html": "<p>Do <em>you</em> have an <strong>IPod</strong> yet?</p>",
+				"tags": "Announcing, Mystery",
+				"image": {"src": "https://cdn.shopify.com/s/files/1/0005/4838/0009/articles/ipod.jpg", "alt": "iPod"},
+				"published": true
+			}}`,
+			201,
+			loadFixture("article.json")))
+
+	published := true
+	article := Article{
+		Title:    "get on the train now",
+		Author:   "Dennis",
+		BodyHTML: "<p>Do <em>you</em> have an <strong>IPod</strong> yet?</p>",
+		Tags:     "Announcing, Mystery",
+		Image: &ArticleImage{
+			Src: "https://cdn.shopify.com/s/files/1/0005/4838/0009/articles/ipod.jpg",
+			Alt: "iPod",
+		},
+		Published: &published,
+	}
+
+	created, err := client.Article.Create(241253187, article)
+	if err != nil {
+		t.Errorf("Article.Create returned error: %v", err)
+	}
+
+	articleTests(t, *created)
+}
+
+func TestArticleUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/blogs/241253187/articles/134645308.json",
+		bodyCheckingResponder(t,
+			`{"article": {"id": 134645308, "title": "My new Title", "published": false}}`,
+			200,
+			loadFixture("article.json")))
+
+	published := false
+	article := Article{
+		ID:        134645308,
+		Title:     "My new Title",
+		Published: &published,
+	}
+
+	updated, err := client.Article.Update(241253187, article)
+	if err != nil {
+		t.Errorf("Article.Update returned error: %v", err)
+	}
+
+	articleTests(t, *updated)
+}
+
+func TestArticleDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/blogs/241253187/articles/134645308.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Article.Delete(241253187, 134645308)
+	if err != nil {
+		t.Errorf("Article.Delete returned error: %v", err)
+	}
+}
+
+func TestArticleListAuthors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/articles/authors.json",
+		httpmock.NewStringResponder(200, `{"authors": ["Dennis", "John", "dennis"]}`))
+
+	authors, err := client.Article.ListAuthors()
+	if err != nil {
+		t.Errorf("Article.ListAuthors returned error: %v", err)
+	}
+
+	expected := []string{"Dennis", "John", "dennis"}
+	if !reflect.DeepEqual(authors, expected) {
+		t.Errorf("Article.ListAuthors returned %+v, expected %+v", authors, expected)
+	}
+}
+
+func TestArticleListTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/articles/tags.json?limit=1&popular=1",
+		httpmock.NewStringResponder(200, `{"tags": ["Mystery"]}`))
+
+	tags, err := client.Article.ListTags(ArticleTagsOptions{Limit: 1, Popular: 1})
+	if err != nil {
+		t.Errorf("Article.ListTags returned error: %v", err)
+	}
+
+	expected := []string{"Mystery"}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Errorf("Article.ListTags returned %+v, expected %+v", tags, expected)
+	}
+}
+
+func TestArticleListMetafields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/articles/1/metafields.json",
+		httpmock.NewStringResponder(200, `{"metafields": [{"id":1},{"id":2}]}`))
+
+	metafields, err := client.Article.ListMetafields(1, nil)
+	if err != nil {
+		t.Errorf("Article.ListMetafields() returned error: %v", err)
+	}
+
+	expected := []Metafield{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(metafields, expected) {
+		t.Errorf("Article.ListMetafields() returned %+v, expected %+v", metafields, expected)
+	}
+}
+
+func TestArticleCountMetafields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/articles/1/metafields/count.json",
+		httpmock.NewStringResponder(200, `{"count": 3}`))
+
+	cnt, err := client.Article.CountMetafields(1, nil)
+	if err != nil {
+		t.Errorf("Article.CountMetafields() returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("Article.CountMetafields() returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestArticleGetMetafield(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/articles/1/metafields/2.json",
+		httpmock.NewStringResponder(200, `{"metafield": {"id":2}}`))
+
+	metafield, err := client.Article.GetMetafield(1, 2, nil)
+	if err != nil {
+		t.Errorf("Article.GetMetafield() returned error: %v", err)
+	}
+
+	expected := &Metafield{ID: 2}
+	if !reflect.DeepEqual(metafield, expected) {
+		t.Errorf("Article.GetMetafield() returned %+v, expected %+v", metafield, expected)
+	}
+}
+
+func TestArticleCreateMetafield(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/articles/1/metafields.json",
+		httpmock.NewBytesResponder(200, loadFixture("metafield.json")))
+
+	metafield := Metafield{
+		Key:       "app_key",
+		Value:     "app_value",
+		ValueType: "string",
+		Namespace: "affiliates",
+	}
+
+	returnedMetafield, err := client.Article.CreateMetafield(1, metafield)
+	if err != nil {
+		t.Errorf("Article.CreateMetafield() returned error: %v", err)
+	}
+
+	MetafieldTests(t, *returnedMetafield)
+}
+
+func TestArticleUpdateMetafield(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/articles/1/metafields/2.json",
+		httpmock.NewBytesResponder(200, loadFixture("metafield.json")))
+
+	metafield := Metafield{
+		ID:        2,
+		Key:       "app_key",
+		Value:     "app_value",
+		ValueType: "string",
+		Namespace: "affiliates",
+	}
+
+	returnedMetafield, err := client.Article.UpdateMetafield(1, metafield)
+	if err != nil {
+		t.Errorf("Article.UpdateMetafield() returned error: %v", err)
+	}
+
+	MetafieldTests(t, *returnedMetafield)
+}
+
+func TestArticleDeleteMetafield(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/articles/1/metafields/2.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Article.DeleteMetafield(1, 2)
+	if err != nil {
+		t.Errorf("Article.DeleteMetafield() returned error: %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package goshopify
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const shopifyPaymentsBasePath = "admin/shopify_payments"
+
+// BalanceService is an interface for interfacing with the Shopify Payments
+// balance endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/shopify_payments/balance
+type BalanceService interface {
+	Get() ([]Balance, error)
+	ListTransactions(interface{}) ([]BalanceTransaction, error)
+}
+
+// BalanceServiceOp handles communication with the Shopify Payments balance
+// related methods of the Shopify API.
+type BalanceServiceOp struct {
+	client *Client
+}
+
+// Balance represents the Shopify Payments balance of a shop in a currency.
+type Balance struct {
+	Currency string           `json:"currency,omitempty"`
+	Amount   *decimal.Decimal `json:"amount,omitempty"`
+}
+
+// Types of balance transactions
+const (
+	BalanceTransactionTypeCharge               = "charge"
+	BalanceTransactionTypeRefund               = "refund"
+	BalanceTransactionTypeDispute              = "dispute"
+	BalanceTransactionTypeReserve              = "reserve"
+	BalanceTransactionTypeAdjustment           = "adjustment"
+	BalanceTransactionTypeCredit               = "credit"
+	BalanceTransactionTypeDebit                = "debit"
+	BalanceTransactionTypePayout               = "payout"
+	BalanceTransactionTypePayoutFailure        = "payout_failure"
+	BalanceTransactionTypePayoutCancellation   = "payout_cancellation"
+	BalanceTransactionTypeApplicationFeeRefund = "application_fee_refund"
+)
+
+// BalanceTransaction represents a transaction that moved money into or out
+// of the Shopify Payments balance. PayoutID is the payout the transaction is
+// paid out with.
+type BalanceTransaction struct {
+	ID                       int64            `json:"id,omitempty"`
+	Type                     string           `json:"type,omitempty"`
+	Test                     bool             `json:"test,omitempty"`
+	PayoutID                 int64            `json:"payout_id,omitempty"`
+	PayoutStatus             string           `json:"payout_status,omitempty"`
+	Currency                 string           `json:"currency,omitempty"`
+	Amount                   *decimal.Decimal `json:"amount,omitempty"`
+	Fee                      *decimal.Decimal `json:"fee,omitempty"`
+	Net                      *decimal.Decimal `json:"net,omitempty"`
+	SourceID                 int64            `json:"source_id,omitempty"`
+	SourceType               string           `json:"source_type,omitempty"`
+	SourceOrderID            int64            `json:"source_order_id,omitempty"`
+	SourceOrderTransactionID int64            `json:"source_order_transaction_id,omitempty"`
+	ProcessedAt              *time.Time       `json:"processed_at,omitempty"`
+}
+
+// ShopifyPaymentsListOptions are the pagination options of the Shopify
+// Payments endpoints, which list the most recent entities first. Set LastID
+// to the ID of the last entity of a page to get the next, older page, or
+// SinceID to get the entities after it.
+type ShopifyPaymentsListOptions struct {
+	Limit   int   `url:"limit,omitempty"`
+	SinceID int64 `url:"since_id,omitempty"`
+	LastID  int64 `url:"last_id,omitempty"`
+}
+
+// BalanceTransactionListOptions filters the balance transactions to list,
+// e.g. by the payout they're paid out with
+type BalanceTransactionListOptions struct {
+	ShopifyPaymentsListOptions
+	Test         *bool  `url:"test,omitempty"`
+	PayoutID     int64  `url:"payout_id,omitempty"`
+	PayoutStatus string `url:"payout_status,omitempty"`
+}
+
+// Get gets the balances of the shop, one for each currency.
+func (s *BalanceServiceOp) Get() ([]Balance, error) {
+	path := shopifyPaymentsBasePath + "/balance.json"
+	resource := struct {
+		Balances []Balance `json:"balances"`
+	}{}
+	err := s.client.Get(path, &resource, nil)
+	return resource.Balances, err
+}
+
+// ListTransactions gets the balance transactions, most recent first.
+func (s *BalanceServiceOp) ListTransactions(options interface{}) ([]BalanceTransaction, error) {
+	path := shopifyPaymentsBasePath + "/balance/transactions"
+	return NewResource[BalanceTransaction](s.client, path, "transaction", "transactions").List(options)
+}
@@ -0,0 +1,65 @@
+package goshopify
+
+import (
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func TestBalanceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/shopify_payments/balance.json",
+		httpmock.NewBytesResponder(200, loadFixture("balance.json")))
+
+	balances, err := client.Balance.Get()
+	if err != nil {
+		t.Fatalf("Balance.Get returned error: %v", err)
+	}
+
+	if len(balances) != 1 {
+		t.Fatalf("Balance.Get returned %d balances, expected 1", len(balances))
+	}
+
+	expectedAmount := decimal.RequireFromString("53.99")
+	if balances[0].Currency != "USD" || balances[0].Amount == nil || !balances[0].Amount.Equals(expectedAmount) {
+		t.Errorf("Balance.Get returned %+v, expected USD %v", balances[0], expectedAmount)
+	}
+}
+
+func TestBalanceListTransactions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/shopify_payments/balance/transactions.json?last_id=699519476&limit=2&payout_id=623721858",
+		httpmock.NewBytesResponder(200, loadFixture("balance_transactions.json")))
+
+	options := BalanceTransactionListOptions{
+		ShopifyPaymentsListOptions: ShopifyPaymentsListOptions{Limit: 2, LastID: 699519476},
+		PayoutID:                   623721858,
+	}
+	transactions, err := client.Balance.ListTransactions(options)
+	if err != nil {
+		t.Fatalf("Balance.ListTransactions returned error: %v", err)
+	}
+
+	if len(transactions) != 2 {
+		t.Fatalf("Balance.ListTransactions returned %d transactions, expected 2", len(transactions))
+	}
+
+	transaction := transactions[0]
+	if transaction.ID != 699519475 || transaction.Type != BalanceTransactionTypeDebit || transaction.PayoutID != 623721858 {
+		t.Errorf("Balance.ListTransactions returned %+v, expected the debit of payout 623721858", transaction)
+	}
+
+	expectedNet := decimal.RequireFromString("-50.00")
+	if transaction.Net == nil || !transaction.Net.Equals(expectedNet) {
+		t.Errorf("BalanceTransaction.Net returned %v, expected %v", transaction.Net, expectedNet)
+	}
+
+	if transaction.ProcessedAt == nil || transaction.ProcessedAt.Year() != 2024 {
+		t.Errorf("BalanceTransaction.ProcessedAt returned %v, expected 2024-01-10", transaction.ProcessedAt)
+	}
+}
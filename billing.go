@@ -0,0 +1,195 @@
+package goshopify
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Plan is a subscription plan of an app, billed with a recurring application
+// charge of the same name. A plan with a CappedAmount also allows usage
+// charges up to that amount, described by its Terms. A Test plan is billed
+// with test charges and a live plan with live charges, and a charge only
+// counts for a plan if it is billed the same way.
+type Plan struct {
+	Name         string
+	Price        decimal.Decimal
+	TrialDays    int
+	CappedAmount *decimal.Decimal
+	Terms        string
+	Test         bool
+}
+
+// charge returns the recurring application charge that bills the plan
+func (p Plan) charge(returnURL string) RecurringApplicationCharge {
+	price := p.Price
+	charge := RecurringApplicationCharge{
+		Name:         p.Name,
+		Price:        &price,
+		TrialDays:    p.TrialDays,
+		CappedAmount: p.CappedAmount,
+		Terms:        p.Terms,
+		ReturnURL:    returnURL,
+	}
+	if p.Test {
+		test := true
+		charge.Test = &test
+	}
+	return charge
+}
+
+// matches reports whether the recurring application charge bills the plan,
+// i.e. has its name and is a test charge if and only if the plan is a test
+// plan
+func (p Plan) matches(charge RecurringApplicationCharge) bool {
+	test := charge.Test != nil && *charge.Test
+	return charge.Name == p.Name && test == p.Test
+}
+
+// hasTerms reports whether the recurring application charge has the price,
+// trial days and capped amount of the plan
+func (p Plan) hasTerms(charge RecurringApplicationCharge) bool {
+	if charge.Price == nil || !charge.Price.Equal(p.Price) || charge.TrialDays != p.TrialDays {
+		return false
+	}
+	if charge.CappedAmount == nil || p.CappedAmount == nil {
+		return charge.CappedAmount == nil && p.CappedAmount == nil
+	}
+	return charge.CappedAmount.Equal(*p.CappedAmount)
+}
+
+// Billing enforces that a shop subscribed to one of the plans of an app. The
+// merchant approves a plan on the confirmation URL returned by Require, after
+// which Shopify redirects to ReturnURL with a charge_id query parameter, to
+// be passed to HandleReturn.
+//
+// For example:
+//
+//	billing := goshopify.Billing{Plans: plans, ReturnURL: "https://app.example.com/billing"}
+//	url, status, err := billing.Require(client, "Basic")
+//	if err == nil && !status.Active {
+//		// Redirect the merchant to url.
+//	}
+type Billing struct {
+	Plans     []Plan
+	ReturnURL string
+}
+
+// BillingStatus is the billing status of a shop. Active reports whether the
+// shop has an active charge for one of the plans, Plan and Charge are that
+// plan and charge. After HandleReturn, Charge is the returned charge even if
+// the merchant declined it.
+type BillingStatus struct {
+	Active bool
+	Plan   *Plan
+	Charge *RecurringApplicationCharge
+}
+
+// plan returns the plan of the given name, or nil if there is none
+func (b Billing) plan(name string) *Plan {
+	for i := range b.Plans {
+		if b.Plans[i].Name == name {
+			return &b.Plans[i]
+		}
+	}
+	return nil
+}
+
+// planFor returns the plan billed by the recurring application charge, or nil
+// if there is none
+func (b Billing) planFor(charge RecurringApplicationCharge) *Plan {
+	for i := range b.Plans {
+		if b.Plans[i].matches(charge) {
+			return &b.Plans[i]
+		}
+	}
+	return nil
+}
+
+// Status returns the billing status of the shop of the client
+func (b Billing) Status(client *Client) (*BillingStatus, error) {
+	charges, err := client.RecurringApplicationCharge.List(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, charge := range charges {
+		if charge.Status != RecurringApplicationChargeStatusActive {
+			continue
+		}
+		if plan := b.planFor(charge); plan != nil {
+			return &BillingStatus{Active: true, Plan: plan, Charge: &charges[i]}, nil
+		}
+	}
+	return &BillingStatus{}, nil
+}
+
+// Require returns the billing status of the shop of the client if it has an
+// active charge for the named plan. Otherwise it also returns the URL to
+// redirect the merchant to, to approve a charge for the plan. A pending
+// charge for the plan with its current terms is reused, so that reloading
+// the page doesn't create a new charge every time.
+func (b Billing) Require(client *Client, planName string) (string, *BillingStatus, error) {
+	plan := b.plan(planName)
+	if plan == nil {
+		return "", nil, fmt.Errorf("unknown plan %q", planName)
+	}
+
+	charges, err := client.RecurringApplicationCharge.List(nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	status := &BillingStatus{}
+	for i, charge := range charges {
+		if !plan.matches(charge) {
+			continue
+		}
+		switch charge.Status {
+		case RecurringApplicationChargeStatusActive:
+			return "", &BillingStatus{Active: true, Plan: plan, Charge: &charges[i]}, nil
+		case RecurringApplicationChargeStatusPending:
+			if charge.ReturnURL == b.ReturnURL && charge.ConfirmationURL != "" && plan.hasTerms(charge) {
+				status.Charge = &charges[i]
+			}
+		}
+	}
+
+	if status.Charge == nil {
+		status.Charge, err = client.RecurringApplicationCharge.Create(plan.charge(b.ReturnURL))
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	status.Plan = plan
+	return status.Charge.ConfirmationURL, status, nil
+}
+
+// HandleReturn handles the redirect to ReturnURL after the merchant approved
+// or declined the charge of the given charge_id. It activates the charge if
+// it was accepted but isn't active yet, and returns the resulting status.
+func (b Billing) HandleReturn(client *Client, chargeID int64) (*BillingStatus, error) {
+	charge, err := client.RecurringApplicationCharge.Get(chargeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := b.planFor(*charge)
+	if plan == nil {
+		return nil, fmt.Errorf("recurring application charge %d is not for a known plan: %q (test: %t)",
+			chargeID, charge.Name, charge.Test != nil && *charge.Test)
+	}
+
+	if charge.Status == RecurringApplicationChargeStatusAccepted {
+		charge, err = client.RecurringApplicationCharge.Activate(*charge)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &BillingStatus{
+		Active: charge.Status == RecurringApplicationChargeStatusActive,
+		Plan:   plan,
+		Charge: charge,
+	}, nil
+}
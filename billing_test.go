@@ -0,0 +1,258 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+var testBilling = Billing{
+	Plans: []Plan{
+		{Name: "Basic", Price: decimal.RequireFromString("9.99"), TrialDays: 7, Test: true},
+		{
+			Name:         "Usage",
+			Price:        decimal.RequireFromString("19.99"),
+			CappedAmount: decimalPtr("100"),
+			Terms:        "$1 per 1000 emails",
+		},
+	},
+	ReturnURL: "https://app.example.com/billing",
+}
+
+func registerCharges(charges string) {
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/recurring_application_charges.json",
+		httpmock.NewStringResponder(200, `{"recurring_application_charges": `+charges+`}`))
+}
+
+func TestBillingStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerCharges(`[
+		{"id": 1, "name": "Legacy", "status": "active"},
+		{"id": 2, "name": "Basic", "status": "declined"},
+		{"id": 3, "name": "Usage", "status": "active"}
+	]`)
+
+	status, err := testBilling.Status(client)
+	if err != nil {
+		t.Fatalf("Billing.Status returned error: %v", err)
+	}
+
+	if !status.Active || status.Plan == nil || status.Plan.Name != "Usage" || status.Charge.ID != 3 {
+		t.Errorf("Billing.Status returned %+v, expected the active Usage charge", status)
+	}
+}
+
+func TestBillingStatusInactive(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerCharges(`[{"id": 1, "name": "Legacy", "status": "active"}, {"id": 2, "name": "Basic", "status": "cancelled"}]`)
+
+	status, err := testBilling.Status(client)
+	if err != nil {
+		t.Fatalf("Billing.Status returned error: %v", err)
+	}
+
+	if status.Active || status.Plan != nil || status.Charge != nil {
+		t.Errorf("Billing.Status returned %+v, expected an inactive status", status)
+	}
+}
+
+func TestBillingRequireActive(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerCharges(`[{"id": 2, "name": "Basic", "status": "active", "test": true}]`)
+
+	url, status, err := testBilling.Require(client, "Basic")
+	if err != nil {
+		t.Fatalf("Billing.Require returned error: %v", err)
+	}
+
+	if url != "" || !status.Active || status.Charge.ID != 2 {
+		t.Errorf("Billing.Require returned %q, %+v, expected the active charge", url, status)
+	}
+}
+
+func TestBillingRequireCreatesCharge(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerCharges(`[{"id": 1, "name": "Basic", "status": "declined", "confirmation_url": "https://fooshop.myshopify.com/confirm/1"}]`)
+
+	var created RecurringApplicationCharge
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/recurring_application_charges.json",
+		func(req *http.Request) (*http.Response, error) {
+			body := RecurringApplicationChargeResource{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return httpmock.NewStringResponse(400, `{"errors": "bad request"}`), nil
+			}
+			created = *body.Charge
+			return httpmock.NewStringResponse(201, `{"recurring_application_charge": {
+				"id": 4, "name": "Usage", "status": "pending",
+				"confirmation_url": "https://fooshop.myshopify.com/confirm/4"
+			}}`), nil
+		})
+
+	url, status, err := testBilling.Require(client, "Usage")
+	if err != nil {
+		t.Fatalf("Billing.Require returned error: %v", err)
+	}
+
+	expectedURL := "https://fooshop.myshopify.com/confirm/4"
+	if url != expectedURL {
+		t.Errorf("Billing.Require returned %q, expected %q", url, expectedURL)
+	}
+	if status.Active || status.Plan.Name != "Usage" || status.Charge.ID != 4 {
+		t.Errorf("Billing.Require returned %+v, expected the pending Usage charge", status)
+	}
+
+	if created.Name != "Usage" || !created.Price.Equals(decimal.RequireFromString("19.99")) ||
+		created.CappedAmount == nil || !created.CappedAmount.Equals(decimal.RequireFromString("100")) ||
+		created.Terms != "$1 per 1000 emails" || created.ReturnURL != testBilling.ReturnURL || created.Test != nil {
+		t.Errorf("Billing.Require created %+v, expected a charge for the Usage plan", created)
+	}
+}
+
+func TestBillingRequireReusesPendingCharge(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerCharges(`[{
+		"id": 5, "name": "Basic", "status": "pending", "test": true,
+		"price": "9.99", "trial_days": 7,
+		"return_url": "https://app.example.com/billing",
+		"confirmation_url": "https://fooshop.myshopify.com/confirm/5"
+	}]`)
+
+	url, status, err := testBilling.Require(client, "Basic")
+	if err != nil {
+		t.Fatalf("Billing.Require returned error: %v", err)
+	}
+
+	expectedURL := "https://fooshop.myshopify.com/confirm/5"
+	if url != expectedURL || status.Charge.ID != 5 {
+		t.Errorf("Billing.Require returned %q, %+v, expected the pending charge", url, status)
+	}
+
+	calls := httpmock.GetCallCountInfo()["POST https://fooshop.myshopify.com/admin/recurring_application_charges.json"]
+	if calls != 0 {
+		t.Errorf("Billing.Require created %d charges, expected 0", calls)
+	}
+}
+
+func TestBillingTestMismatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// The Basic plan is a test plan and the Usage plan a live plan, so
+	// neither charge bills its plan
+	registerCharges(`[
+		{"id": 6, "name": "Basic", "status": "active"},
+		{"id": 7, "name": "Usage", "status": "active", "test": true}
+	]`)
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/recurring_application_charges.json",
+		httpmock.NewStringResponder(201, `{"recurring_application_charge": {
+			"id": 8, "name": "Basic", "status": "pending", "test": true,
+			"confirmation_url": "https://fooshop.myshopify.com/confirm/8"
+		}}`))
+
+	status, err := testBilling.Status(client)
+	if err != nil {
+		t.Fatalf("Billing.Status returned error: %v", err)
+	}
+	if status.Active {
+		t.Errorf("Billing.Status returned %+v, expected an inactive status", status)
+	}
+
+	url, status, err := testBilling.Require(client, "Basic")
+	if err != nil {
+		t.Fatalf("Billing.Require returned error: %v", err)
+	}
+	if url != "https://fooshop.myshopify.com/confirm/8" || status.Active || status.Charge.ID != 8 {
+		t.Errorf("Billing.Require returned %q, %+v, expected a new test charge", url, status)
+	}
+}
+
+func TestBillingRequireRepricedPendingCharge(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerCharges(`[{
+		"id": 5, "name": "Basic", "status": "pending", "test": true,
+		"price": "4.99", "trial_days": 7,
+		"return_url": "https://app.example.com/billing",
+		"confirmation_url": "https://fooshop.myshopify.com/confirm/5"
+	}]`)
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/recurring_application_charges.json",
+		httpmock.NewStringResponder(201, `{"recurring_application_charge": {
+			"id": 9, "name": "Basic", "status": "pending", "test": true, "price": "9.99", "trial_days": 7,
+			"confirmation_url": "https://fooshop.myshopify.com/confirm/9"
+		}}`))
+
+	url, status, err := testBilling.Require(client, "Basic")
+	if err != nil {
+		t.Fatalf("Billing.Require returned error: %v", err)
+	}
+
+	expectedURL := "https://fooshop.myshopify.com/confirm/9"
+	if url != expectedURL || status.Charge.ID != 9 {
+		t.Errorf("Billing.Require returned %q, %+v, expected a new charge at the current price", url, status)
+	}
+}
+
+func TestBillingRequireUnknownPlan(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := testBilling.Require(client, "Enterprise")
+	if err == nil {
+		t.Errorf("Billing.Require of an unknown plan expected an error")
+	}
+}
+
+func TestBillingHandleReturn(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/recurring_application_charges/4.json",
+		httpmock.NewStringResponder(200, `{"recurring_application_charge": {"id": 4, "name": "Usage", "status": "accepted"}}`))
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/recurring_application_charges/4/activate.json",
+		httpmock.NewStringResponder(200, `{"recurring_application_charge": {"id": 4, "name": "Usage", "status": "active"}}`))
+
+	status, err := testBilling.HandleReturn(client, 4)
+	if err != nil {
+		t.Fatalf("Billing.HandleReturn returned error: %v", err)
+	}
+
+	if !status.Active || status.Plan.Name != "Usage" || status.Charge.Status != RecurringApplicationChargeStatusActive {
+		t.Errorf("Billing.HandleReturn returned %+v, expected the activated charge", status)
+	}
+}
+
+func TestBillingHandleReturnDeclined(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/recurring_application_charges/4.json",
+		httpmock.NewStringResponder(200, `{"recurring_application_charge": {"id": 4, "name": "Usage", "status": "declined"}}`))
+
+	status, err := testBilling.HandleReturn(client, 4)
+	if err != nil {
+		t.Fatalf("Billing.HandleReturn returned error: %v", err)
+	}
+
+	if status.Active || status.Charge.Status != RecurringApplicationChargeStatusDeclined {
+		t.Errorf("Billing.HandleReturn returned %+v, expected the declined charge", status)
+	}
+
+	calls := httpmock.GetCallCountInfo()["POST https://fooshop.myshopify.com/admin/recurring_application_charges/4/activate.json"]
+	if calls != 0 {
+		t.Errorf("Billing.HandleReturn activated the charge %d times, expected 0", calls)
+	}
+}
@@ -1,9 +1,6 @@
 package goshopify
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
 
 const blogsBasePath = "admin/blogs"
 
@@ -13,10 +10,10 @@ const blogsBasePath = "admin/blogs"
 type BlogService interface {
 	List(interface{}) ([]Blog, error)
 	Count(interface{}) (int, error)
-	Get(int, interface{}) (*Blog, error)
+	Get(int64, interface{}) (*Blog, error)
 	Create(Blog) (*Blog, error)
 	Update(Blog) (*Blog, error)
-	Delete(int) error
+	Delete(int64) error
 }
 
 // BlogServiceOp handles communication with the blog related methods of
@@ -27,7 +24,7 @@ type BlogServiceOp struct {
 
 // Blog represents a Shopify blog
 type Blog struct {
-	ID                 int        `json:"id"`
+	ID                 int64      `json:"id"`
 	Title              string     `json:"title"`
 	Commentable        string     `json:"commentable"`
 	Feedburner         string     `json:"feedburner"`
@@ -38,59 +35,54 @@ type Blog struct {
 	TemplateSuffix     string     `json:"template_suffix"`
 	CreatedAt          *time.Time `json:"created_at"`
 	UpdatedAt          *time.Time `json:"updated_at"`
+	AdminGraphqlAPIID  string     `json:"admin_graphql_api_id,omitempty"`
 }
 
 // BlogsResource is the result from the blogs.json endpoint
+//
+// Deprecated: BlogService.List returns the blogs directly.
 type BlogsResource struct {
 	Blogs []Blog `json:"blogs"`
 }
 
 // Represents the result from the blogs/X.json endpoint
+//
+// Deprecated: BlogService returns and takes a Blog directly.
 type BlogResource struct {
 	Blog *Blog `json:"blog"`
 }
 
+// blogs returns the resource for the blogs endpoints
+func (s *BlogServiceOp) blogs() *Resource[Blog] {
+	return NewResource[Blog](s.client, blogsBasePath, "blog", "blogs")
+}
+
 // List all blogs
 func (s *BlogServiceOp) List(options interface{}) ([]Blog, error) {
-	path := fmt.Sprintf("%s.json", blogsBasePath)
-	resource := new(BlogsResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Blogs, err
+	return s.blogs().List(options)
 }
 
 // Count blogs
 func (s *BlogServiceOp) Count(options interface{}) (int, error) {
-	path := fmt.Sprintf("%s/count.json", blogsBasePath)
-	return s.client.Count(path, options)
+	return s.blogs().Count(options)
 }
 
 // Get single blog
-func (s *BlogServiceOp) Get(blogId int, options interface{}) (*Blog, error) {
-	path := fmt.Sprintf("%s/%d.json", blogsBasePath, blogId)
-	resource := new(BlogResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Blog, err
+func (s *BlogServiceOp) Get(blogId int64, options interface{}) (*Blog, error) {
+	return s.blogs().Get(blogId, options)
 }
 
 // Create a new blog
 func (s *BlogServiceOp) Create(blog Blog) (*Blog, error) {
-	path := fmt.Sprintf("%s.json", blogsBasePath)
-	wrappedData := BlogResource{Blog: &blog}
-	resource := new(BlogResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Blog, err
+	return s.blogs().Create(blog)
 }
 
 // Update an existing blog
 func (s *BlogServiceOp) Update(blog Blog) (*Blog, error) {
-	path := fmt.Sprintf("%s/%d.json", blogsBasePath, blog.ID)
-	wrappedData := BlogResource{Blog: &blog}
-	resource := new(BlogResource)
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.Blog, err
+	return s.blogs().Update(blog.ID, blog)
 }
 
 // Delete an blog
-func (s *BlogServiceOp) Delete(blogId int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", blogsBasePath, blogId))
+func (s *BlogServiceOp) Delete(blogId int64) error {
+	return s.blogs().Delete(blogId)
 }
@@ -0,0 +1,239 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const carrierServicesBasePath = "admin/carrier_services"
+
+// shippingRateDateFormat is the format of the delivery dates of a shipping
+// rate, e.g. 2013-04-12 14:48:45 -0400
+const shippingRateDateFormat = "2006-01-02 15:04:05 -0700"
+
+// CarrierServiceService is an interface for interfacing with the carrier
+// services endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/shipping-and-fulfillment/carrierservice
+type CarrierServiceService interface {
+	List(interface{}) ([]CarrierService, error)
+	Get(int64, interface{}) (*CarrierService, error)
+	Create(CarrierService) (*CarrierService, error)
+	Update(CarrierService) (*CarrierService, error)
+	Delete(int64) error
+}
+
+// CarrierServiceServiceOp handles communication with the carrier service
+// related methods of the Shopify API.
+type CarrierServiceServiceOp struct {
+	client *Client
+}
+
+// CarrierService represents a Shopify carrier service, which provides
+// shipping rates from the CallbackURL at checkout
+type CarrierService struct {
+	ID                 int64  `json:"id,omitempty"`
+	Name               string `json:"name,omitempty"`
+	Active             *bool  `json:"active,omitempty"`
+	ServiceDiscovery   bool   `json:"service_discovery,omitempty"`
+	CarrierServiceType string `json:"carrier_service_type,omitempty"`
+	Format             string `json:"format,omitempty"`
+	CallbackURL        string `json:"callback_url,omitempty"`
+	AdminGraphqlAPIID  string `json:"admin_graphql_api_id,omitempty"`
+}
+
+// ShippingRateRequest is the request Shopify sends to the callback URL of a
+// carrier service
+type ShippingRateRequest struct {
+	Rate ShippingRateQuery `json:"rate"`
+}
+
+// ShippingRateQuery contains the origin, destination and items to calculate
+// the shipping rates for
+type ShippingRateQuery struct {
+	Origin      ShippingRateAddress `json:"origin"`
+	Destination ShippingRateAddress `json:"destination"`
+	Items       []ShippingRateItem  `json:"items"`
+	Currency    string              `json:"currency"`
+	Locale      string              `json:"locale"`
+}
+
+// ShippingRateAddress is the origin or destination of a shipping rate query
+type ShippingRateAddress struct {
+	Country     string  `json:"country"`
+	PostalCode  string  `json:"postal_code"`
+	Province    string  `json:"province"`
+	City        string  `json:"city"`
+	Name        string  `json:"name"`
+	Address1    string  `json:"address1"`
+	Address2    string  `json:"address2"`
+	Address3    string  `json:"address3"`
+	Phone       string  `json:"phone"`
+	Fax         string  `json:"fax"`
+	Email       string  `json:"email"`
+	AddressType string  `json:"address_type"`
+	CompanyName string  `json:"company_name"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+}
+
+// ShippingRateItem is an item of a shipping rate query. Price is in cents.
+type ShippingRateItem struct {
+	Name               string                 `json:"name"`
+	SKU                string                 `json:"sku"`
+	Quantity           int                    `json:"quantity"`
+	Grams              int                    `json:"grams"`
+	Price              int64                  `json:"price"`
+	Vendor             string                 `json:"vendor"`
+	RequiresShipping   bool                   `json:"requires_shipping"`
+	Taxable            bool                   `json:"taxable"`
+	FulfillmentService string                 `json:"fulfillment_service"`
+	Properties         map[string]interface{} `json:"properties"`
+	ProductID          int64                  `json:"product_id"`
+	VariantID          int64                  `json:"variant_id"`
+}
+
+// ShippingRate is a shipping rate returned to Shopify by a carrier service.
+// TotalPrice is in the currency of the rate and is encoded in cents.
+type ShippingRate struct {
+	ServiceName     string
+	ServiceCode     string
+	Description     string
+	TotalPrice      decimal.Decimal
+	Currency        string
+	MinDeliveryDate *time.Time
+	MaxDeliveryDate *time.Time
+}
+
+// shippingRateJSON is the JSON encoding of a ShippingRate
+type shippingRateJSON struct {
+	ServiceName     string `json:"service_name"`
+	ServiceCode     string `json:"service_code"`
+	Description     string `json:"description,omitempty"`
+	TotalPrice      string `json:"total_price"`
+	Currency        string `json:"currency"`
+	MinDeliveryDate string `json:"min_delivery_date,omitempty"`
+	MaxDeliveryDate string `json:"max_delivery_date,omitempty"`
+}
+
+// MarshalJSON encodes the shipping rate with its total price in cents, e.g.
+// "1295" for 12.95
+func (r ShippingRate) MarshalJSON() ([]byte, error) {
+	rate := shippingRateJSON{
+		ServiceName: r.ServiceName,
+		ServiceCode: r.ServiceCode,
+		Description: r.Description,
+		TotalPrice:  r.TotalPrice.Shift(2).Round(0).String(),
+		Currency:    r.Currency,
+	}
+	if r.MinDeliveryDate != nil {
+		rate.MinDeliveryDate = r.MinDeliveryDate.Format(shippingRateDateFormat)
+	}
+	if r.MaxDeliveryDate != nil {
+		rate.MaxDeliveryDate = r.MaxDeliveryDate.Format(shippingRateDateFormat)
+	}
+	return json.Marshal(rate)
+}
+
+// UnmarshalJSON decodes a shipping rate with its total price in cents
+func (r *ShippingRate) UnmarshalJSON(data []byte) error {
+	rate := shippingRateJSON{}
+	if err := json.Unmarshal(data, &rate); err != nil {
+		return err
+	}
+
+	totalPrice, err := decimal.NewFromString(rate.TotalPrice)
+	if err != nil {
+		return err
+	}
+
+	*r = ShippingRate{
+		ServiceName: rate.ServiceName,
+		ServiceCode: rate.ServiceCode,
+		Description: rate.Description,
+		TotalPrice:  totalPrice.Shift(-2),
+		Currency:    rate.Currency,
+	}
+	if rate.MinDeliveryDate != "" {
+		d, err := time.Parse(shippingRateDateFormat, rate.MinDeliveryDate)
+		if err != nil {
+			return err
+		}
+		r.MinDeliveryDate = &d
+	}
+	if rate.MaxDeliveryDate != "" {
+		d, err := time.Parse(shippingRateDateFormat, rate.MaxDeliveryDate)
+		if err != nil {
+			return err
+		}
+		r.MaxDeliveryDate = &d
+	}
+	return nil
+}
+
+// ShippingRateFunc calculates the shipping rates for a rate request
+type ShippingRateFunc func(ShippingRateRequest) ([]ShippingRate, error)
+
+// CarrierServiceHandler returns an http.Handler for the callback URL of a
+// carrier service. It verifies that the request was sent by Shopify, decodes
+// the rate request, and responds with the rates calculated by fn. Errors of
+// fn result in a 500 response, which makes Shopify fall back to its backup
+// rates.
+func (app App) CarrierServiceHandler(fn ShippingRateFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !app.VerifyWebhookRequest(r) {
+			http.Error(w, "Invalid Signature", http.StatusUnauthorized)
+			return
+		}
+
+		request := ShippingRateRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, "Invalid rate request", http.StatusBadRequest)
+			return
+		}
+
+		rates, err := fn(request)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if rates == nil {
+			rates = []ShippingRate{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string][]ShippingRate{"rates": rates})
+	})
+}
+
+// carrierServices returns the resource for the carrier services endpoints
+func (s *CarrierServiceServiceOp) carrierServices() *Resource[CarrierService] {
+	return NewResource[CarrierService](s.client, carrierServicesBasePath, "carrier_service", "carrier_services")
+}
+
+// List carrier services
+func (s *CarrierServiceServiceOp) List(options interface{}) ([]CarrierService, error) {
+	return s.carrierServices().List(options)
+}
+
+// Get individual carrier service
+func (s *CarrierServiceServiceOp) Get(carrierServiceID int64, options interface{}) (*CarrierService, error) {
+	return s.carrierServices().Get(carrierServiceID, options)
+}
+
+// Create a new carrier service
+func (s *CarrierServiceServiceOp) Create(carrierService CarrierService) (*CarrierService, error) {
+	return s.carrierServices().Create(carrierService)
+}
+
+// Update an existing carrier service
+func (s *CarrierServiceServiceOp) Update(carrierService CarrierService) (*CarrierService, error) {
+	return s.carrierServices().Update(carrierService.ID, carrierService)
+}
+
+// Delete an existing carrier service
+func (s *CarrierServiceServiceOp) Delete(carrierServiceID int64) error {
+	return s.carrierServices().Delete(carrierServiceID)
+}
@@ -0,0 +1,240 @@
+package goshopify
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func carrierServiceTests(t *testing.T, carrierService CarrierService) {
+	// Check that the ID is assigned to the returned carrier service
+	expectedID := int64(1036894960)
+	if carrierService.ID != expectedID {
+		t.Errorf("CarrierService.ID returned %+v, expected %+v", carrierService.ID, expectedID)
+	}
+
+	expectedURL := "http://shippingrateprovider.com/"
+	if carrierService.CallbackURL != expectedURL {
+		t.Errorf("CarrierService.CallbackURL returned %+v, expected %+v", carrierService.CallbackURL, expectedURL)
+	}
+
+	if carrierService.Active == nil || !*carrierService.Active {
+		t.Errorf("CarrierService.Active returned %+v, expected %+v", carrierService.Active, true)
+	}
+}
+
+// signedRequest returns a request with the given body, signed with the
+// secret of the test app the same way Shopify signs webhooks
+func signedRequest(body []byte) *http.Request {
+	mac := hmac.New(sha256.New, []byte(app.ApiSecret))
+	mac.Write(body)
+	req := httptest.NewRequest("POST", "/shipping-rates", bytes.NewReader(body))
+	req.Header.Set("X-Shopify-Hmac-Sha256", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func TestCarrierServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/carrier_services.json",
+		httpmock.NewBytesResponder(200, loadFixture("carrier_services.json")))
+
+	carrierServices, err := client.CarrierService.List(nil)
+	if err != nil {
+		t.Errorf("CarrierService.List returned error: %v", err)
+	}
+
+	if len(carrierServices) != 2 {
+		t.Fatalf("CarrierService.List returned %d carrier services, expected 2", len(carrierServices))
+	}
+	carrierServiceTests(t, carrierServices[0])
+}
+
+func TestCarrierServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/carrier_services/1036894960.json?fields=id%2Cname%2Cactive%2Cservice_discovery%2Ccarrier_service_type%2Cadmin_graphql_api_id",
+		httpmock.NewBytesResponder(200, loadFixture("carrier_service.json")))
+
+	options := ListOptions{Fields: "id,name,active,service_discovery,carrier_service_type,admin_graphql_api_id"}
+	carrierService, err := client.CarrierService.Get(1036894960, options)
+	if err != nil {
+		t.Errorf("CarrierService.Get returned error: %v", err)
+	}
+
+	carrierServiceTests(t, *carrierService)
+}
+
+func TestCarrierServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/carrier_services.json",
+		bodyCheckingResponder(t,
+			`{"carrier_service": {"name": "Shipping Rate Provider", "callback_url": "http://shippingrateprovider.com/", "service_discovery": true}}`,
+			201,
+			loadFixture("carrier_service.json")))
+
+	carrierService := CarrierService{
+		Name:             "Shipping Rate Provider",
+		CallbackURL:      "http://shippingrateprovider.com/",
+		ServiceDiscovery: true,
+	}
+
+	created, err := client.CarrierService.Create(carrierService)
+	if err != nil {
+		t.Errorf("CarrierService.Create returned error: %v", err)
+	}
+
+	carrierServiceTests(t, *created)
+}
+
+func TestCarrierServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/carrier_services/1036894960.json",
+		bodyCheckingResponder(t,
+			`{"carrier_service": {"id": 1036894960, "active": false}}`,
+			200,
+			[]byte(`{"carrier_service": {"id": 1036894960, "active": false}}`)))
+
+	active := false
+	carrierService := CarrierService{
+		ID:     1036894960,
+		Active: &active,
+	}
+
+	updated, err := client.CarrierService.Update(carrierService)
+	if err != nil {
+		t.Errorf("CarrierService.Update returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(updated, &carrierService) {
+		t.Errorf("CarrierService.Update returned %+v, expected %+v", updated, carrierService)
+	}
+}
+
+func TestCarrierServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/carrier_services/1036894960.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CarrierService.Delete(1036894960)
+	if err != nil {
+		t.Errorf("CarrierService.Delete returned error: %v", err)
+	}
+}
+
+func TestShippingRateJSON(t *testing.T) {
+	minDate := time.Date(2013, time.April, 12, 14, 48, 45, 0, time.FixedZone("", -4*60*60))
+	maxDate := minDate.Add(48 * time.Hour)
+	rate := ShippingRate{
+		ServiceName:     "Endertech Overnight",
+		ServiceCode:     "ON",
+		TotalPrice:      decimal.RequireFromString("12.95"),
+		Currency:        "USD",
+		MinDeliveryDate: &minDate,
+		MaxDeliveryDate: &maxDate,
+	}
+
+	data, err := json.Marshal(rate)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	expected := `{"service_name":"Endertech Overnight","service_code":"ON","total_price":"1295","currency":"USD",` +
+		`"min_delivery_date":"2013-04-12 14:48:45 -0400","max_delivery_date":"2013-04-14 14:48:45 -0400"}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal returned %s, expected %s", data, expected)
+	}
+
+	decoded := ShippingRate{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if !decoded.TotalPrice.Equals(rate.TotalPrice) {
+		t.Errorf("ShippingRate.TotalPrice returned %+v, expected %+v", decoded.TotalPrice, rate.TotalPrice)
+	}
+	if decoded.MinDeliveryDate == nil || !decoded.MinDeliveryDate.Equal(minDate) {
+		t.Errorf("ShippingRate.MinDeliveryDate returned %+v, expected %+v", decoded.MinDeliveryDate, minDate)
+	}
+}
+
+func TestCarrierServiceHandler(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var received ShippingRateRequest
+	handler := app.CarrierServiceHandler(func(req ShippingRateRequest) ([]ShippingRate, error) {
+		received = req
+		return []ShippingRate{
+			{
+				ServiceName: "Express",
+				ServiceCode: "express",
+				TotalPrice:  decimal.RequireFromString("9.5"),
+				Currency:    req.Rate.Currency,
+			},
+		}, nil
+	})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, signedRequest(loadFixture("shipping_rate_request.json")))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("CarrierServiceHandler returned status %d, expected %d", w.Code, http.StatusOK)
+	}
+
+	expected := `{"rates":[{"service_name":"Express","service_code":"express","total_price":"950","currency":"USD"}]}` + "\n"
+	if w.Body.String() != expected {
+		t.Errorf("CarrierServiceHandler returned %s, expected %s", w.Body.String(), expected)
+	}
+
+	if received.Rate.Destination.PostalCode != "K1M1M4" {
+		t.Errorf("ShippingRateRequest.Rate.Destination.PostalCode returned %+v, expected %+v", received.Rate.Destination.PostalCode, "K1M1M4")
+	}
+	if len(received.Rate.Items) != 1 || received.Rate.Items[0].Price != 1999 {
+		t.Errorf("ShippingRateRequest.Rate.Items returned %+v, expected one item of 1999 cents", received.Rate.Items)
+	}
+}
+
+func TestCarrierServiceHandlerErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	failing := app.CarrierServiceHandler(func(req ShippingRateRequest) ([]ShippingRate, error) {
+		return nil, errors.New("no rates")
+	})
+
+	cases := []struct {
+		req      *http.Request
+		expected int
+	}{
+		{httptest.NewRequest("POST", "/shipping-rates", bytes.NewReader(loadFixture("shipping_rate_request.json"))), http.StatusUnauthorized},
+		{signedRequest([]byte("not json")), http.StatusBadRequest},
+		{signedRequest(loadFixture("shipping_rate_request.json")), http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		failing.ServeHTTP(w, c.req)
+		if w.Code != c.expected {
+			t.Errorf("CarrierServiceHandler returned status %d, expected %d", w.Code, c.expected)
+		}
+	}
+}
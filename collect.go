@@ -0,0 +1,77 @@
+package goshopify
+
+import "time"
+
+const collectsBasePath = "admin/collects"
+
+// collectionsBasePath is the path of the endpoints shared by smart and custom
+// collections
+const collectionsBasePath = "admin/collections"
+
+// CollectService is an interface for interfacing with the collects endpoints
+// of the Shopify API. A collect adds a product to a custom collection.
+// See: https://help.shopify.com/api/reference/products/collect
+type CollectService interface {
+	List(interface{}) ([]Collect, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*Collect, error)
+	Create(Collect) (*Collect, error)
+	Delete(int64) error
+}
+
+// CollectServiceOp handles communication with the collect related methods of
+// the Shopify API.
+type CollectServiceOp struct {
+	client *Client
+}
+
+// Collect represents a Shopify collect, the membership of a product in a
+// custom collection. Position only applies to manually sorted collections.
+type Collect struct {
+	ID           int64      `json:"id,omitempty"`
+	CollectionID int64      `json:"collection_id,omitempty"`
+	ProductID    int64      `json:"product_id,omitempty"`
+	Position     int        `json:"position,omitempty"`
+	SortValue    string     `json:"sort_value,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+// CollectListOptions filters the collects to list or count by product or
+// collection
+type CollectListOptions struct {
+	ListOptions
+	ProductID    int64 `url:"product_id,omitempty"`
+	CollectionID int64 `url:"collection_id,omitempty"`
+}
+
+// collects returns the resource for the collects endpoints
+func (s *CollectServiceOp) collects() *Resource[Collect] {
+	return NewResource[Collect](s.client, collectsBasePath, "collect", "collects")
+}
+
+// List collects
+func (s *CollectServiceOp) List(options interface{}) ([]Collect, error) {
+	return s.collects().List(options)
+}
+
+// Count collects
+func (s *CollectServiceOp) Count(options interface{}) (int, error) {
+	return s.collects().Count(options)
+}
+
+// Get individual collect
+func (s *CollectServiceOp) Get(collectID int64, options interface{}) (*Collect, error) {
+	return s.collects().Get(collectID, options)
+}
+
+// Create a new collect, which adds a product to a custom collection
+func (s *CollectServiceOp) Create(collect Collect) (*Collect, error) {
+	return s.collects().Create(collect)
+}
+
+// Delete an existing collect, which removes a product from a custom
+// collection
+func (s *CollectServiceOp) Delete(collectID int64) error {
+	return s.collects().Delete(collectID)
+}
@@ -0,0 +1,117 @@
+package goshopify
+
+import (
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+)
+
+func collectTests(t *testing.T, collect Collect) {
+	// Check that the ID is assigned to the returned collect
+	expectedID := int64(455204334)
+	if collect.ID != expectedID {
+		t.Errorf("Collect.ID returned %+v, expected %+v", collect.ID, expectedID)
+	}
+
+	expectedCollectionID := int64(841564295)
+	if collect.CollectionID != expectedCollectionID {
+		t.Errorf("Collect.CollectionID returned %+v, expected %+v", collect.CollectionID, expectedCollectionID)
+	}
+
+	expectedProductID := int64(632910392)
+	if collect.ProductID != expectedProductID {
+		t.Errorf("Collect.ProductID returned %+v, expected %+v", collect.ProductID, expectedProductID)
+	}
+
+	expectedPosition := 1
+	if collect.Position != expectedPosition {
+		t.Errorf("Collect.Position returned %+v, expected %+v", collect.Position, expectedPosition)
+	}
+}
+
+func TestCollectList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/collects.json?product_id=632910392",
+		httpmock.NewBytesResponder(200, loadFixture("collects.json")))
+
+	collects, err := client.Collect.List(CollectListOptions{ProductID: 632910392})
+	if err != nil {
+		t.Errorf("Collect.List returned error: %v", err)
+	}
+
+	if len(collects) != 2 {
+		t.Fatalf("Collect.List returned %d collects, expected 2", len(collects))
+	}
+	collectTests(t, collects[0])
+}
+
+func TestCollectCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/collects/count.json?collection_id=841564295",
+		httpmock.NewStringResponder(200, `{"count": 1}`))
+
+	cnt, err := client.Collect.Count(CollectListOptions{CollectionID: 841564295})
+	if err != nil {
+		t.Errorf("Collect.Count returned error: %v", err)
+	}
+
+	expected := 1
+	if cnt != expected {
+		t.Errorf("Collect.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestCollectGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/collects/455204334.json",
+		httpmock.NewBytesResponder(200, loadFixture("collect.json")))
+
+	collect, err := client.Collect.Get(455204334, nil)
+	if err != nil {
+		t.Errorf("Collect.Get returned error: %v", err)
+	}
+
+	collectTests(t, *collect)
+}
+
+func TestCollectCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/collects.json",
+		bodyCheckingResponder(t,
+			`{"collect": {"collection_id": 841564295, "product_id": 632910392}}`,
+			201,
+			loadFixture("collect.json")))
+
+	collect := Collect{
+		CollectionID: 841564295,
+		ProductID:    632910392,
+	}
+
+	created, err := client.Collect.Create(collect)
+	if err != nil {
+		t.Errorf("Collect.Create returned error: %v", err)
+	}
+
+	collectTests(t, *created)
+}
+
+func TestCollectDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/collects/455204334.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Collect.Delete(455204334)
+	if err != nil {
+		t.Errorf("Collect.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const collectionListingsBasePath = "admin/collection_listings"
+
+// CollectionListingService is an interface for interfacing with the
+// collection listings endpoints of the Shopify API. Collection listings are
+// the collections published to the sales channel of the app. Unlike product
+// listings, Shopify has no count endpoint for them.
+// See: https://help.shopify.com/api/reference/sales-channels/collectionlisting
+type CollectionListingService interface {
+	List(interface{}) ([]CollectionListing, error)
+	Get(int64, interface{}) (*CollectionListing, error)
+	ListProductIDs(int64, interface{}) ([]int64, error)
+	Publish(int64) (*CollectionListing, error)
+	Unpublish(int64) error
+}
+
+// CollectionListingServiceOp handles communication with the collection
+// listing related methods of the Shopify API.
+type CollectionListingServiceOp struct {
+	client *Client
+}
+
+// CollectionListing represents a smart or custom collection published to a
+// sales channel
+type CollectionListing struct {
+	CollectionID        int64      `json:"collection_id,omitempty"`
+	Title               string     `json:"title,omitempty"`
+	BodyHTML            string     `json:"body_html,omitempty"`
+	Handle              string     `json:"handle,omitempty"`
+	SortOrder           string     `json:"sort_order,omitempty"`
+	Image               *Image     `json:"image,omitempty"`
+	DefaultProductImage *Image     `json:"default_product_image,omitempty"`
+	PublishedAt         *time.Time `json:"published_at,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+}
+
+// collectionListings returns the resource for the collection listings
+// endpoints
+func (s *CollectionListingServiceOp) collectionListings() *Resource[CollectionListing] {
+	return NewResource[CollectionListing](s.client, collectionListingsBasePath, "collection_listing", "collection_listings")
+}
+
+// List collection listings
+func (s *CollectionListingServiceOp) List(options interface{}) ([]CollectionListing, error) {
+	return s.collectionListings().List(options)
+}
+
+// Get the listing of a collection
+func (s *CollectionListingServiceOp) Get(collectionID int64, options interface{}) (*CollectionListing, error) {
+	return s.collectionListings().Get(collectionID, options)
+}
+
+// ListProductIDs lists the IDs of the published products of a published
+// collection
+func (s *CollectionListingServiceOp) ListProductIDs(collectionID int64, options interface{}) ([]int64, error) {
+	path := fmt.Sprintf("%s/%d/product_ids.json", collectionListingsBasePath, collectionID)
+	resource := new(struct {
+		ProductIDs []int64 `json:"product_ids"`
+	})
+	err := s.client.Get(path, resource, options)
+	return resource.ProductIDs, err
+}
+
+// Publish a collection to the sales channel
+func (s *CollectionListingServiceOp) Publish(collectionID int64) (*CollectionListing, error) {
+	data := map[string]CollectionListing{"collection_listing": {CollectionID: collectionID}}
+	return s.collectionListings().Do("PUT", fmt.Sprintf("%d", collectionID), data, nil)
+}
+
+// Unpublish a collection from the sales channel
+func (s *CollectionListingServiceOp) Unpublish(collectionID int64) error {
+	return s.collectionListings().Delete(collectionID)
+}
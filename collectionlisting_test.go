@@ -0,0 +1,110 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+)
+
+func collectionListingTests(t *testing.T, collectionListing CollectionListing) {
+	// Check that the collection ID is assigned to the returned collection listing
+	expectedID := int64(482865238)
+	if collectionListing.CollectionID != expectedID {
+		t.Errorf("CollectionListing.CollectionID returned %+v, expected %+v", collectionListing.CollectionID, expectedID)
+	}
+
+	if collectionListing.SortOrder != CollectionSortOrderManual {
+		t.Errorf("CollectionListing.SortOrder returned %+v, expected %+v", collectionListing.SortOrder, CollectionSortOrderManual)
+	}
+
+	if collectionListing.Image == nil || collectionListing.Image.Width != 123 {
+		t.Errorf("CollectionListing.Image returned %+v, expected an image of width %d", collectionListing.Image, 123)
+	}
+
+	if collectionListing.DefaultProductImage != nil {
+		t.Errorf("CollectionListing.DefaultProductImage returned %+v, expected nil", collectionListing.DefaultProductImage)
+	}
+}
+
+func TestCollectionListingList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/collection_listings.json",
+		httpmock.NewBytesResponder(200, loadFixture("collection_listings.json")))
+
+	collectionListings, err := client.CollectionListing.List(nil)
+	if err != nil {
+		t.Errorf("CollectionListing.List returned error: %v", err)
+	}
+
+	if len(collectionListings) != 1 {
+		t.Fatalf("CollectionListing.List returned %d collection listings, expected 1", len(collectionListings))
+	}
+	collectionListingTests(t, collectionListings[0])
+}
+
+func TestCollectionListingGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/collection_listings/482865238.json",
+		httpmock.NewBytesResponder(200, loadFixture("collection_listing.json")))
+
+	collectionListing, err := client.CollectionListing.Get(482865238, nil)
+	if err != nil {
+		t.Errorf("CollectionListing.Get returned error: %v", err)
+	}
+
+	collectionListingTests(t, *collectionListing)
+}
+
+func TestCollectionListingListProductIDs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/collection_listings/482865238/product_ids.json",
+		httpmock.NewStringResponder(200, `{"product_ids": [632910392]}`))
+
+	productIDs, err := client.CollectionListing.ListProductIDs(482865238, nil)
+	if err != nil {
+		t.Errorf("CollectionListing.ListProductIDs returned error: %v", err)
+	}
+
+	expected := []int64{632910392}
+	if !reflect.DeepEqual(productIDs, expected) {
+		t.Errorf("CollectionListing.ListProductIDs returned %+v, expected %+v", productIDs, expected)
+	}
+}
+
+func TestCollectionListingPublish(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/collection_listings/482865238.json",
+		bodyCheckingResponder(t,
+			`{"collection_listing": {"collection_id": 482865238}}`,
+			200,
+			loadFixture("collection_listing.json")))
+
+	collectionListing, err := client.CollectionListing.Publish(482865238)
+	if err != nil {
+		t.Errorf("CollectionListing.Publish returned error: %v", err)
+	}
+
+	collectionListingTests(t, *collectionListing)
+}
+
+func TestCollectionListingUnpublish(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/collection_listings/482865238.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CollectionListing.Unpublish(482865238)
+	if err != nil {
+		t.Errorf("CollectionListing.Unpublish returned error: %v", err)
+	}
+}
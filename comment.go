@@ -0,0 +1,135 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const commentsBasePath = "admin/comments"
+
+// CommentService is an interface for interfacing with the comments endpoints
+// of the Shopify API. Comments can't be deleted, only removed with Remove.
+// See: https://help.shopify.com/api/reference/online_store/comment
+type CommentService interface {
+	List(interface{}) ([]Comment, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*Comment, error)
+	Create(Comment) (*Comment, error)
+	Update(Comment) (*Comment, error)
+	Spam(int64) (*Comment, error)
+	NotSpam(int64) (*Comment, error)
+	Approve(int64) (*Comment, error)
+	Remove(int64) (*Comment, error)
+	Restore(int64) (*Comment, error)
+}
+
+// CommentServiceOp handles communication with the comment related methods of
+// the Shopify API.
+type CommentServiceOp struct {
+	client *Client
+}
+
+// Statuses of a comment
+const (
+	CommentStatusPending    = "pending"
+	CommentStatusUnapproved = "unapproved"
+	CommentStatusPublished  = "published"
+	CommentStatusSpam       = "spam"
+	CommentStatusRemoved    = "removed"
+)
+
+// Comment represents a comment on a blog article. Status is one of the
+// CommentStatus constants.
+type Comment struct {
+	ID          int64      `json:"id,omitempty"`
+	ArticleID   int64      `json:"article_id,omitempty"`
+	BlogID      int64      `json:"blog_id,omitempty"`
+	Author      string     `json:"author,omitempty"`
+	Email       string     `json:"email,omitempty"`
+	Body        string     `json:"body,omitempty"`
+	BodyHTML    string     `json:"body_html,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	IP          string     `json:"ip,omitempty"`
+	UserAgent   string     `json:"user_agent,omitempty"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// CommentListOptions filters the comments to list or count by blog, article
+// and status
+type CommentListOptions struct {
+	ListOptions
+	BlogID    int64  `url:"blog_id,omitempty"`
+	ArticleID int64  `url:"article_id,omitempty"`
+	Status    string `url:"status,omitempty"`
+}
+
+// comments returns the resource for the comments endpoints
+func (s *CommentServiceOp) comments() *Resource[Comment] {
+	return NewResource[Comment](s.client, commentsBasePath, "comment", "comments")
+}
+
+// List comments
+func (s *CommentServiceOp) List(options interface{}) ([]Comment, error) {
+	return s.comments().List(options)
+}
+
+// Count comments
+func (s *CommentServiceOp) Count(options interface{}) (int, error) {
+	return s.comments().Count(options)
+}
+
+// Get individual comment
+func (s *CommentServiceOp) Get(commentID int64, options interface{}) (*Comment, error) {
+	return s.comments().Get(commentID, options)
+}
+
+// Create a new comment on the article of its ArticleID and BlogID
+func (s *CommentServiceOp) Create(comment Comment) (*Comment, error) {
+	return s.comments().Create(comment)
+}
+
+// Update an existing comment
+func (s *CommentServiceOp) Update(comment Comment) (*Comment, error) {
+	return s.comments().Update(comment.ID, comment)
+}
+
+// moderate performs a POST request to a moderation endpoint of a comment,
+// e.g. comments/1/spam.json. Unlike the other comments endpoints, these
+// return the comment without a "comment" root key.
+func (s *CommentServiceOp) moderate(commentID int64, action string) (*Comment, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", commentsBasePath, commentID, action)
+	comment := new(Comment)
+	err := s.client.Post(path, nil, comment)
+	if err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// Spam marks a comment as spam
+func (s *CommentServiceOp) Spam(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "spam")
+}
+
+// NotSpam marks a comment as not spam, which makes it pending or published
+// depending on the moderation settings of its blog
+func (s *CommentServiceOp) NotSpam(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "not_spam")
+}
+
+// Approve a comment and publish it
+func (s *CommentServiceOp) Approve(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "approve")
+}
+
+// Remove a comment
+func (s *CommentServiceOp) Remove(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "remove")
+}
+
+// Restore a removed comment
+func (s *CommentServiceOp) Restore(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "restore")
+}
@@ -0,0 +1,170 @@
+package goshopify
+
+import (
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+)
+
+func commentTests(t *testing.T, comment Comment) {
+	// Check that the ID is assigned to the returned comment
+	expectedID := int64(653537639)
+	if comment.ID != expectedID {
+		t.Errorf("Comment.ID returned %+v, expected %+v", comment.ID, expectedID)
+	}
+
+	expectedArticleID := int64(134645308)
+	if comment.ArticleID != expectedArticleID {
+		t.Errorf("Comment.ArticleID returned %+v, expected %+v", comment.ArticleID, expectedArticleID)
+	}
+
+	if comment.Status != CommentStatusUnapproved {
+		t.Errorf("Comment.Status returned %+v, expected %+v", comment.Status, CommentStatusUnapproved)
+	}
+
+	expectedAuthor := "Soleone"
+	if comment.Author != expectedAuthor {
+		t.Errorf("Comment.Author returned %+v, expected %+v", comment.Author, expectedAuthor)
+	}
+}
+
+func TestCommentList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/comments.json?article_id=134645308&blog_id=241253187",
+		httpmock.NewBytesResponder(200, loadFixture("comments.json")))
+
+	comments, err := client.Comment.List(CommentListOptions{BlogID: 241253187, ArticleID: 134645308})
+	if err != nil {
+		t.Errorf("Comment.List returned error: %v", err)
+	}
+
+	if len(comments) != 2 {
+		t.Fatalf("Comment.List returned %d comments, expected 2", len(comments))
+	}
+	commentTests(t, comments[0])
+}
+
+func TestCommentCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/comments/count.json?status=published",
+		httpmock.NewStringResponder(200, `{"count": 1}`))
+
+	cnt, err := client.Comment.Count(CommentListOptions{Status: CommentStatusPublished})
+	if err != nil {
+		t.Errorf("Comment.Count returned error: %v", err)
+	}
+
+	expected := 1
+	if cnt != expected {
+		t.Errorf("Comment.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestCommentGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/comments/653537639.json",
+		httpmock.NewBytesResponder(200, loadFixture("comment.json")))
+
+	comment, err := client.Comment.Get(653537639, nil)
+	if err != nil {
+		t.Errorf("Comment.Get returned error: %v", err)
+	}
+
+	commentTests(t, *comment)
+}
+
+func TestCommentCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/comments.json",
+		bodyCheckingResponder(t,
+			`{"comment": {
+				"article_id": 134645308,
+				"blog_id": 241253187,
+				"author": "Soleone",
+				"email": "sole@one.de",
+				"body": "Hi author, I really _like_ what you're doing there.",
+				"ip": "127.0.0.1"
+			}}`,
+			201,
+			loadFixture("comment.json")))
+
+	comment := Comment{
+		ArticleID: 134645308,
+		BlogID:    241253187,
+		Author:    "Soleone",
+		Email:     "sole@one.de",
+		Body:      "Hi author, I really _like_ what you're doing there.",
+		IP:        "127.0.0.1",
+	}
+
+	created, err := client.Comment.Create(comment)
+	if err != nil {
+		t.Errorf("Comment.Create returned error: %v", err)
+	}
+
+	commentTests(t, *created)
+}
+
+func TestCommentUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/comments/653537639.json",
+		bodyCheckingResponder(t,
+			`{"comment": {"id": 653537639, "body": "You can even update through a web service."}}`,
+			200,
+			loadFixture("comment.json")))
+
+	comment := Comment{
+		ID:   653537639,
+		Body: "You can even update through a web service.",
+	}
+
+	updated, err := client.Comment.Update(comment)
+	if err != nil {
+		t.Errorf("Comment.Update returned error: %v", err)
+	}
+
+	commentTests(t, *updated)
+}
+
+func TestCommentModeration(t *testing.T) {
+	cases := []struct {
+		action string
+		status string
+		call   func(CommentService, int64) (*Comment, error)
+	}{
+		{"spam", CommentStatusSpam, CommentService.Spam},
+		{"not_spam", CommentStatusPublished, CommentService.NotSpam},
+		{"approve", CommentStatusPublished, CommentService.Approve},
+		{"remove", CommentStatusRemoved, CommentService.Remove},
+		{"restore", CommentStatusPublished, CommentService.Restore},
+	}
+
+	for _, c := range cases {
+		setup()
+
+		httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/comments/653537639/"+c.action+".json",
+			bodyCheckingResponder(t, `null`, 200, loadFixture("comment_moderation/"+c.action+".json")))
+
+		comment, err := c.call(client.Comment, 653537639)
+		if err != nil {
+			t.Errorf("Comment %s returned error: %v", c.action, err)
+		} else if comment == nil {
+			t.Errorf("Comment %s returned nil, expected comment 653537639", c.action)
+		} else if comment.ID != 653537639 || comment.Status != c.status {
+			t.Errorf("Comment %s returned comment %d with status %s, expected comment 653537639 with status %s",
+				c.action, comment.ID, comment.Status, c.status)
+		}
+
+		teardown()
+	}
+}
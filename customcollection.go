@@ -1,9 +1,6 @@
 package goshopify
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
 
 const customCollectionsBasePath = "admin/custom_collections"
 const customCollectionsResourceName = "collections"
@@ -14,10 +11,10 @@ const customCollectionsResourceName = "collections"
 type CustomCollectionService interface {
 	List(interface{}) ([]CustomCollection, error)
 	Count(interface{}) (int, error)
-	Get(int, interface{}) (*CustomCollection, error)
+	Get(int64, interface{}) (*CustomCollection, error)
 	Create(CustomCollection) (*CustomCollection, error)
 	Update(CustomCollection) (*CustomCollection, error)
-	Delete(int) error
+	Delete(int64) error
 
 	// MetafieldsService used for CustomCollection resource to communicate with Metafields resource
 	MetafieldsService
@@ -31,108 +28,103 @@ type CustomCollectionServiceOp struct {
 
 // CustomCollection represents a Shopify custom collection.
 type CustomCollection struct {
-	ID             int         `json:"id"`
-	Handle         string      `json:"handle"`
-	Title          string      `json:"title"`
-	UpdatedAt      *time.Time  `json:"updated_at"`
-	BodyHTML       string      `json:"body_html"`
-	SortOrder      string      `json:"sort_order"`
-	TemplateSuffix string      `json:"template_suffix"`
-	Image          Image       `json:"image"`
-	Published      bool        `json:"published"`
-	PublishedAt    *time.Time  `json:"published_at"`
-	PublishedScope string      `json:"published_scope"`
-	Metafields     []Metafield `json:"metafields,omitempty"`
+	ID                int64       `json:"id"`
+	Handle            string      `json:"handle"`
+	Title             string      `json:"title"`
+	UpdatedAt         *time.Time  `json:"updated_at"`
+	BodyHTML          string      `json:"body_html"`
+	SortOrder         string      `json:"sort_order"`
+	TemplateSuffix    string      `json:"template_suffix"`
+	Image             Image       `json:"image"`
+	Published         bool        `json:"published"`
+	PublishedAt       *time.Time  `json:"published_at"`
+	PublishedScope    string      `json:"published_scope"`
+	Metafields        []Metafield `json:"metafields,omitempty"`
+	AdminGraphqlAPIID string      `json:"admin_graphql_api_id,omitempty"`
 }
 
 // CustomCollectionResource represents the result form the custom_collections/X.json endpoint
+//
+// Deprecated: CustomCollectionService returns and takes a CustomCollection directly.
 type CustomCollectionResource struct {
 	Collection *CustomCollection `json:"custom_collection"`
 }
 
 // CustomCollectionsResource represents the result from the custom_collections.json endpoint
+//
+// Deprecated: CustomCollectionService.List returns the collections directly.
 type CustomCollectionsResource struct {
 	Collections []CustomCollection `json:"custom_collections"`
 }
 
+// customCollections returns the resource for the custom collections endpoints
+func (s *CustomCollectionServiceOp) customCollections() *Resource[CustomCollection] {
+	return NewResource[CustomCollection](s.client, customCollectionsBasePath, "custom_collection", "custom_collections")
+}
+
 // List custom collections
 func (s *CustomCollectionServiceOp) List(options interface{}) ([]CustomCollection, error) {
-	path := fmt.Sprintf("%s.json", customCollectionsBasePath)
-	resource := new(CustomCollectionsResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Collections, err
+	return s.customCollections().List(options)
 }
 
 // Count custom collections
 func (s *CustomCollectionServiceOp) Count(options interface{}) (int, error) {
-	path := fmt.Sprintf("%s/count.json", customCollectionsBasePath)
-	return s.client.Count(path, options)
+	return s.customCollections().Count(options)
 }
 
 // Get individual custom collection
-func (s *CustomCollectionServiceOp) Get(collectionID int, options interface{}) (*CustomCollection, error) {
-	path := fmt.Sprintf("%s/%d.json", customCollectionsBasePath, collectionID)
-	resource := new(CustomCollectionResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Collection, err
+func (s *CustomCollectionServiceOp) Get(collectionID int64, options interface{}) (*CustomCollection, error) {
+	return s.customCollections().Get(collectionID, options)
 }
 
 // Create a new custom collection
 // See Image for the details of the Image creation for a collection.
 func (s *CustomCollectionServiceOp) Create(collection CustomCollection) (*CustomCollection, error) {
-	path := fmt.Sprintf("%s.json", customCollectionsBasePath)
-	wrappedData := CustomCollectionResource{Collection: &collection}
-	resource := new(CustomCollectionResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Collection, err
+	return s.customCollections().Create(collection)
 }
 
 // Update an existing custom collection
 func (s *CustomCollectionServiceOp) Update(collection CustomCollection) (*CustomCollection, error) {
-	path := fmt.Sprintf("%s/%d.json", customCollectionsBasePath, collection.ID)
-	wrappedData := CustomCollectionResource{Collection: &collection}
-	resource := new(CustomCollectionResource)
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.Collection, err
+	return s.customCollections().Update(collection.ID, collection)
 }
 
 // Delete an existing custom collection.
-func (s *CustomCollectionServiceOp) Delete(collectionID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", customCollectionsBasePath, collectionID))
+func (s *CustomCollectionServiceOp) Delete(collectionID int64) error {
+	return s.customCollections().Delete(collectionID)
 }
 
 // List metafields for a custom collection
-func (s *CustomCollectionServiceOp) ListMetafields(customCollectionID int, options interface{}) ([]Metafield, error) {
+func (s *CustomCollectionServiceOp) ListMetafields(customCollectionID int64, options interface{}) ([]Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customCollectionsResourceName, resourceID: customCollectionID}
 	return metafieldService.List(options)
 }
 
 // Count metafields for a custom collection
-func (s *CustomCollectionServiceOp) CountMetafields(customCollectionID int, options interface{}) (int, error) {
+func (s *CustomCollectionServiceOp) CountMetafields(customCollectionID int64, options interface{}) (int, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customCollectionsResourceName, resourceID: customCollectionID}
 	return metafieldService.Count(options)
 }
 
 // Get individual metafield for a custom collection
-func (s *CustomCollectionServiceOp) GetMetafield(customCollectionID int, metafieldID int, options interface{}) (*Metafield, error) {
+func (s *CustomCollectionServiceOp) GetMetafield(customCollectionID int64, metafieldID int64, options interface{}) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customCollectionsResourceName, resourceID: customCollectionID}
 	return metafieldService.Get(metafieldID, options)
 }
 
 // Create a new metafield for a custom collection
-func (s *CustomCollectionServiceOp) CreateMetafield(customCollectionID int, metafield Metafield) (*Metafield, error) {
+func (s *CustomCollectionServiceOp) CreateMetafield(customCollectionID int64, metafield Metafield) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customCollectionsResourceName, resourceID: customCollectionID}
 	return metafieldService.Create(metafield)
 }
 
 // Update an existing metafield for a custom collection
-func (s *CustomCollectionServiceOp) UpdateMetafield(customCollectionID int, metafield Metafield) (*Metafield, error) {
+func (s *CustomCollectionServiceOp) UpdateMetafield(customCollectionID int64, metafield Metafield) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customCollectionsResourceName, resourceID: customCollectionID}
 	return metafieldService.Update(metafield)
 }
 
 // // Delete an existing metafield for a custom collection
-func (s *CustomCollectionServiceOp) DeleteMetafield(customCollectionID int, metafieldID int) error {
+func (s *CustomCollectionServiceOp) DeleteMetafield(customCollectionID int64, metafieldID int64) error {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customCollectionsResourceName, resourceID: customCollectionID}
 	return metafieldService.Delete(metafieldID)
 }
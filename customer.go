@@ -16,12 +16,12 @@ const customersResourceName = "customers"
 type CustomerService interface {
 	List(interface{}) ([]Customer, error)
 	Count(interface{}) (int, error)
-	Get(int, interface{}) (*Customer, error)
+	Get(int64, interface{}) (*Customer, error)
 	Search(interface{}) ([]Customer, error)
 	Create(Customer) (*Customer, error)
 	Update(Customer) (*Customer, error)
-	Delete(int) error
-	ListOrders(int, interface{}) ([]Order, error)
+	Delete(int64) error
+	ListOrders(int64, interface{}) ([]Order, error)
 
 	// MetafieldsService used for Customer resource to communicate with Metafields resource
 	MetafieldsService
@@ -35,7 +35,7 @@ type CustomerServiceOp struct {
 
 // Customer represents a Shopify customer
 type Customer struct {
-	ID                  int                `json:"id,omitempty"`
+	ID                  int64              `json:"id,omitempty"`
 	Email               string             `json:"email,omitempty"`
 	FirstName           string             `json:"first_name,omitempty"`
 	LastName            string             `json:"last_name,omitempty"`
@@ -48,7 +48,7 @@ type Customer struct {
 	TotalSpent          *decimal.Decimal   `json:"total_spent,omitempty"`
 	Phone               string             `json:"phone,omitempty"`
 	Tags                string             `json:"tags,omitempty"`
-	LastOrderId         int                `json:"last_order_id,omitempty"`
+	LastOrderId         int64              `json:"last_order_id,omitempty"`
 	LastOrderName       string             `json:"last_order_name,omitempty"`
 	AcceptsMarketing    bool               `json:"accepts_marketing,omitempty"`
 	DefaultAddress      *CustomerAddress   `json:"default_address,omitempty"`
@@ -56,121 +56,111 @@ type Customer struct {
 	CreatedAt           *time.Time         `json:"created_at,omitempty"`
 	UpdatedAt           *time.Time         `json:"updated_at,omitempty"`
 	Metafields          []Metafield        `json:"metafields,omitempty"`
+	AdminGraphqlAPIID   string             `json:"admin_graphql_api_id,omitempty"`
+}
+
+// Represents the options available when searching for a customer
+type CustomerSearchOptions struct {
+	Page   int    `url:"page,omitempty"`
+	Limit  int    `url:"limit,omitempty"`
+	Fields string `url:"fields,omitempty"`
+	Order  string `url:"order,omitempty"`
+	Query  string `url:"query,omitempty"`
 }
 
 // Represents the result from the customers/X.json endpoint
+//
+// Deprecated: CustomerService returns and takes a Customer directly.
 type CustomerResource struct {
 	Customer *Customer `json:"customer"`
 }
 
 // Represents the result from the customers.json endpoint
+//
+// Deprecated: CustomerService.List and Search return the customers directly.
 type CustomersResource struct {
 	Customers []Customer `json:"customers"`
 }
 
-// Represents the options available when searching for a customer
-type CustomerSearchOptions struct {
-	Page   int    `url:"page,omitempty"`
-	Limit  int    `url:"limit,omitempty"`
-	Fields string `url:"fields,omitempty"`
-	Order  string `url:"order,omitempty"`
-	Query  string `url:"query,omitempty"`
+// customers returns the resource for the customers endpoints
+func (s *CustomerServiceOp) customers() *Resource[Customer] {
+	return NewResource[Customer](s.client, customersBasePath, "customer", "customers")
 }
 
 // List customers
 func (s *CustomerServiceOp) List(options interface{}) ([]Customer, error) {
-	path := fmt.Sprintf("%s.json", customersBasePath)
-	resource := new(CustomersResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Customers, err
+	return s.customers().List(options)
 }
 
 // Count customers
 func (s *CustomerServiceOp) Count(options interface{}) (int, error) {
-	path := fmt.Sprintf("%s/count.json", customersBasePath)
-	return s.client.Count(path, options)
+	return s.customers().Count(options)
 }
 
 // Get customer
-func (s *CustomerServiceOp) Get(customerID int, options interface{}) (*Customer, error) {
-	path := fmt.Sprintf("%s/%v.json", customersBasePath, customerID)
-	resource := new(CustomerResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Customer, err
+func (s *CustomerServiceOp) Get(customerID int64, options interface{}) (*Customer, error) {
+	return s.customers().Get(customerID, options)
 }
 
 // Create a new customer
 func (s *CustomerServiceOp) Create(customer Customer) (*Customer, error) {
-	path := fmt.Sprintf("%s.json", customersBasePath)
-	wrappedData := CustomerResource{Customer: &customer}
-	resource := new(CustomerResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Customer, err
+	return s.customers().Create(customer)
 }
 
 // Update an existing customer
 func (s *CustomerServiceOp) Update(customer Customer) (*Customer, error) {
-	path := fmt.Sprintf("%s/%d.json", customersBasePath, customer.ID)
-	wrappedData := CustomerResource{Customer: &customer}
-	resource := new(CustomerResource)
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.Customer, err
+	return s.customers().Update(customer.ID, customer)
 }
 
 // Delete an existing customer
-func (s *CustomerServiceOp) Delete(customerID int) error {
-	path := fmt.Sprintf("%s/%d.json", customersBasePath, customerID)
-	return s.client.Delete(path)
+func (s *CustomerServiceOp) Delete(customerID int64) error {
+	return s.customers().Delete(customerID)
 }
 
 // Search customers
 func (s *CustomerServiceOp) Search(options interface{}) ([]Customer, error) {
-	path := fmt.Sprintf("%s/search.json", customersBasePath)
-	resource := new(CustomersResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Customers, err
+	path := fmt.Sprintf("%s/search", customersBasePath)
+	return NewResource[Customer](s.client, path, "customer", "customers").List(options)
 }
 
 // List metafields for a customer
-func (s *CustomerServiceOp) ListMetafields(customerID int, options interface{}) ([]Metafield, error) {
+func (s *CustomerServiceOp) ListMetafields(customerID int64, options interface{}) ([]Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customersResourceName, resourceID: customerID}
 	return metafieldService.List(options)
 }
 
 // Count metafields for a customer
-func (s *CustomerServiceOp) CountMetafields(customerID int, options interface{}) (int, error) {
+func (s *CustomerServiceOp) CountMetafields(customerID int64, options interface{}) (int, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customersResourceName, resourceID: customerID}
 	return metafieldService.Count(options)
 }
 
 // Get individual metafield for a customer
-func (s *CustomerServiceOp) GetMetafield(customerID int, metafieldID int, options interface{}) (*Metafield, error) {
+func (s *CustomerServiceOp) GetMetafield(customerID int64, metafieldID int64, options interface{}) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customersResourceName, resourceID: customerID}
 	return metafieldService.Get(metafieldID, options)
 }
 
 // Create a new metafield for a customer
-func (s *CustomerServiceOp) CreateMetafield(customerID int, metafield Metafield) (*Metafield, error) {
+func (s *CustomerServiceOp) CreateMetafield(customerID int64, metafield Metafield) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customersResourceName, resourceID: customerID}
 	return metafieldService.Create(metafield)
 }
 
 // Update an existing metafield for a customer
-func (s *CustomerServiceOp) UpdateMetafield(customerID int, metafield Metafield) (*Metafield, error) {
+func (s *CustomerServiceOp) UpdateMetafield(customerID int64, metafield Metafield) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customersResourceName, resourceID: customerID}
 	return metafieldService.Update(metafield)
 }
 
 // // Delete an existing metafield for a customer
-func (s *CustomerServiceOp) DeleteMetafield(customerID int, metafieldID int) error {
+func (s *CustomerServiceOp) DeleteMetafield(customerID int64, metafieldID int64) error {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customersResourceName, resourceID: customerID}
 	return metafieldService.Delete(metafieldID)
 }
 
 // ListOrders retrieves all orders from a customer
-func (s *CustomerServiceOp) ListOrders(customerID int, options interface{}) ([]Order, error) {
-	path := fmt.Sprintf("%s/%d/orders.json", customersBasePath, customerID)
-	resource := new(OrdersResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Orders, err
+func (s *CustomerServiceOp) ListOrders(customerID int64, options interface{}) ([]Order, error) {
+	path := fmt.Sprintf("%s/%d/orders", customersBasePath, customerID)
+	return NewResource[Order](s.client, path, "order", "orders").List(options)
 }
@@ -8,11 +8,11 @@ const customerAddressResourceName = "customer-addresses"
 // of the Shopify API.
 // See: https://help.shopify.com/en/api/reference/customers/customer_address
 type CustomerAddressService interface {
-	List(int, interface{}) ([]CustomerAddress, error)
-	Get(int, int, interface{}) (*CustomerAddress, error)
-	Create(int, CustomerAddress) (*CustomerAddress, error)
-	Update(int, CustomerAddress) (*CustomerAddress, error)
-	Delete(int, int) error
+	List(int64, interface{}) ([]CustomerAddress, error)
+	Get(int64, int64, interface{}) (*CustomerAddress, error)
+	Create(int64, CustomerAddress) (*CustomerAddress, error)
+	Update(int64, CustomerAddress) (*CustomerAddress, error)
+	Delete(int64, int64) error
 }
 
 // CustomerAddressServiceOp handles communication with the customer address related methods of
@@ -23,70 +23,67 @@ type CustomerAddressServiceOp struct {
 
 // CustomerAddress represents a Shopify customer address
 type CustomerAddress struct {
-	ID           int    `json:"id,omitempty"`
-	CustomerID   int    `json:"customer_id,omitempty"`
-	FirstName    string `json:"first_name,omitempty"`
-	LastName     string `json:"last_name,omitempty"`
-	Company      string `json:"company,omitempty"`
-	Address1     string `json:"address1,omitempty"`
-	Address2     string `json:"address2,omitempty"`
-	City         string `json:"city,omitempty"`
-	Province     string `json:"province,omitempty"`
-	Country      string `json:"country,omitempty"`
-	Zip          string `json:"zip,omitempty"`
-	Phone        string `json:"phone,omitempty"`
-	Name         string `json:"name,omitempty"`
-	ProvinceCode string `json:"province_code,omitempty"`
-	CountryCode  string `json:"country_code,omitempty"`
-	CountryName  string `json:"country_name,omitempty"`
-	Default      bool   `json:"default,omitempty"`
+	ID                int64  `json:"id,omitempty"`
+	CustomerID        int64  `json:"customer_id,omitempty"`
+	FirstName         string `json:"first_name,omitempty"`
+	LastName          string `json:"last_name,omitempty"`
+	Company           string `json:"company,omitempty"`
+	Address1          string `json:"address1,omitempty"`
+	Address2          string `json:"address2,omitempty"`
+	City              string `json:"city,omitempty"`
+	Province          string `json:"province,omitempty"`
+	Country           string `json:"country,omitempty"`
+	Zip               string `json:"zip,omitempty"`
+	Phone             string `json:"phone,omitempty"`
+	Name              string `json:"name,omitempty"`
+	ProvinceCode      string `json:"province_code,omitempty"`
+	CountryCode       string `json:"country_code,omitempty"`
+	CountryName       string `json:"country_name,omitempty"`
+	Default           bool   `json:"default,omitempty"`
+	AdminGraphqlAPIID string `json:"admin_graphql_api_id,omitempty"`
 }
 
 // CustomerAddressResoruce represents the result from the addresses/X.json endpoint
+//
+// Deprecated: CustomerAddressService returns and takes a CustomerAddress directly.
 type CustomerAddressResource struct {
 	Address *CustomerAddress `json:"customer_address"`
 }
 
 // CustomerAddressResoruce represents the result from the customers/X/addresses.json endpoint
+//
+// Deprecated: CustomerAddressService.List returns the addresses directly.
 type CustomerAddressesResource struct {
 	Addresses []CustomerAddress `json:"addresses"`
 }
 
+// addresses returns the resource for the addresses endpoints of a customer
+func (s *CustomerAddressServiceOp) addresses(customerID int64) *Resource[CustomerAddress] {
+	path := fmt.Sprintf("%s/%d/addresses", customersBasePath, customerID)
+	return NewResource[CustomerAddress](s.client, path, "customer_address", "addresses")
+}
+
 // List addresses
-func (s *CustomerAddressServiceOp) List(customerID int, options interface{}) ([]CustomerAddress, error) {
-	path := fmt.Sprintf("%s/%d/addresses.json", customersBasePath, customerID)
-	resource := new(CustomerAddressesResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Addresses, err
+func (s *CustomerAddressServiceOp) List(customerID int64, options interface{}) ([]CustomerAddress, error) {
+	return s.addresses(customerID).List(options)
 }
 
 // Get address
-func (s *CustomerAddressServiceOp) Get(customerID, addressID int, options interface{}) (*CustomerAddress, error) {
-	path := fmt.Sprintf("%s/%d/addresses/%d.json", customersBasePath, customerID, addressID)
-	resource := new(CustomerAddressResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Address, err
+func (s *CustomerAddressServiceOp) Get(customerID, addressID int64, options interface{}) (*CustomerAddress, error) {
+	return s.addresses(customerID).Get(addressID, options)
 }
 
 // Create a new address for given customer
-func (s *CustomerAddressServiceOp) Create(customerID int, address CustomerAddress) (*CustomerAddress, error) {
-	path := fmt.Sprintf("%s/%d/addresses.json", customersBasePath, customerID)
-	wrappedData := CustomerAddressResource{Address: &address}
-	resource := new(CustomerAddressResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Address, err
+func (s *CustomerAddressServiceOp) Create(customerID int64, address CustomerAddress) (*CustomerAddress, error) {
+	return s.addresses(customerID).Create(address)
 }
 
 // Create a new address for given customer
-func (s *CustomerAddressServiceOp) Update(customerID int, address CustomerAddress) (*CustomerAddress, error) {
-	path := fmt.Sprintf("%s/%d/addresses/%d.json", customersBasePath, customerID, address.ID)
-	wrappedData := CustomerAddressResource{Address: &address}
-	resource := new(CustomerAddressResource)
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.Address, err
+func (s *CustomerAddressServiceOp) Update(customerID int64, address CustomerAddress) (*CustomerAddress, error) {
+	return s.addresses(customerID).Update(address.ID, address)
 }
 
 // Delete an existing address
-func (s *CustomerAddressServiceOp) Delete(customerID, addressID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d/addresses/%d.json", customersBasePath, customerID, addressID))
+func (s *CustomerAddressServiceOp) Delete(customerID, addressID int64) error {
+	return s.addresses(customerID).Delete(addressID)
 }
@@ -0,0 +1,215 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const discountCodesBasePath = "admin/discount_codes"
+
+// DiscountCodeBatchLimit is the maximum number of codes of a batch
+const DiscountCodeBatchLimit = 100
+
+// DiscountCodeService is an interface for interfacing with the discount codes
+// endpoints of the Shopify API. Discount codes belong to a price rule.
+// See: https://help.shopify.com/api/reference/discounts/discountcode
+type DiscountCodeService interface {
+	List(int64, interface{}) ([]PriceRuleDiscountCode, error)
+	Count(interface{}) (int, error)
+	Get(int64, int64, interface{}) (*PriceRuleDiscountCode, error)
+	Create(int64, PriceRuleDiscountCode) (*PriceRuleDiscountCode, error)
+	Update(int64, PriceRuleDiscountCode) (*PriceRuleDiscountCode, error)
+	Delete(int64, int64) error
+	Lookup(string) (*PriceRuleDiscountCode, error)
+	CreateBatch(int64, []string) (*DiscountCodeCreation, error)
+	CreateBatches(int64, []string) ([]DiscountCodeCreation, error)
+	GetBatch(int64, int64) (*DiscountCodeCreation, error)
+	ListBatchCodes(int64, int64) ([]PriceRuleDiscountCode, error)
+	WaitForBatch(context.Context, int64, int64, time.Duration) (*DiscountCodeCreation, error)
+}
+
+// DiscountCodeServiceOp handles communication with the discount code related
+// methods of the Shopify API.
+type DiscountCodeServiceOp struct {
+	client *Client
+}
+
+// Statuses of a discount code batch
+const (
+	DiscountCodeCreationStatusQueued    = "queued"
+	DiscountCodeCreationStatusRunning   = "running"
+	DiscountCodeCreationStatusCompleted = "completed"
+)
+
+// PriceRuleDiscountCode represents a discount code of a price rule. Errors
+// is only set on the codes of a batch that failed to be created, keyed by
+// the field that failed, e.g. {"code": ["must be unique"]}.
+type PriceRuleDiscountCode struct {
+	ID          int64               `json:"id,omitempty"`
+	PriceRuleID int64               `json:"price_rule_id,omitempty"`
+	Code        string              `json:"code,omitempty"`
+	UsageCount  int                 `json:"usage_count,omitempty"`
+	Errors      map[string][]string `json:"errors,omitempty"`
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`
+}
+
+// DiscountCodeCreation represents a batch job creating the discount codes of
+// a price rule. Status is one of the DiscountCodeCreationStatus constants.
+type DiscountCodeCreation struct {
+	ID            int64      `json:"id,omitempty"`
+	PriceRuleID   int64      `json:"price_rule_id,omitempty"`
+	Status        string     `json:"status,omitempty"`
+	CodesCount    int        `json:"codes_count,omitempty"`
+	ImportedCount int        `json:"imported_count,omitempty"`
+	FailedCount   int        `json:"failed_count,omitempty"`
+	Logs          []string   `json:"logs,omitempty"`
+	StartedAt     *time.Time `json:"started_at,omitempty"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
+// discountCodes returns the resource for the discount codes endpoints of a
+// price rule
+func (s *DiscountCodeServiceOp) discountCodes(priceRuleID int64) *Resource[PriceRuleDiscountCode] {
+	path := fmt.Sprintf("%s/%d/discount_codes", priceRulesBasePath, priceRuleID)
+	return NewResource[PriceRuleDiscountCode](s.client, path, "discount_code", "discount_codes")
+}
+
+// batches returns the resource for the batch endpoints of a price rule
+func (s *DiscountCodeServiceOp) batches(priceRuleID int64) *Resource[DiscountCodeCreation] {
+	path := fmt.Sprintf("%s/%d/batch", priceRulesBasePath, priceRuleID)
+	return NewResource[DiscountCodeCreation](s.client, path, "discount_code_creation", "discount_code_creations")
+}
+
+// List discount codes of a price rule
+func (s *DiscountCodeServiceOp) List(priceRuleID int64, options interface{}) ([]PriceRuleDiscountCode, error) {
+	return s.discountCodes(priceRuleID).List(options)
+}
+
+// Count discount codes of all price rules
+func (s *DiscountCodeServiceOp) Count(options interface{}) (int, error) {
+	return s.client.Count(fmt.Sprintf("%s/count.json", discountCodesBasePath), options)
+}
+
+// Get individual discount code
+func (s *DiscountCodeServiceOp) Get(priceRuleID, discountCodeID int64, options interface{}) (*PriceRuleDiscountCode, error) {
+	return s.discountCodes(priceRuleID).Get(discountCodeID, options)
+}
+
+// Create a new discount code
+func (s *DiscountCodeServiceOp) Create(priceRuleID int64, discountCode PriceRuleDiscountCode) (*PriceRuleDiscountCode, error) {
+	return s.discountCodes(priceRuleID).Create(discountCode)
+}
+
+// Update an existing discount code
+func (s *DiscountCodeServiceOp) Update(priceRuleID int64, discountCode PriceRuleDiscountCode) (*PriceRuleDiscountCode, error) {
+	return s.discountCodes(priceRuleID).Update(discountCode.ID, discountCode)
+}
+
+// Delete an existing discount code
+func (s *DiscountCodeServiceOp) Delete(priceRuleID, discountCodeID int64) error {
+	return s.discountCodes(priceRuleID).Delete(discountCodeID)
+}
+
+// Lookup a discount code by its code. Shopify redirects the request to the
+// discount code, which the HTTP client follows.
+func (s *DiscountCodeServiceOp) Lookup(code string) (*PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf("%s/lookup.json", discountCodesBasePath)
+	options := struct {
+		Code string `url:"code"`
+	}{code}
+	resource := new(struct {
+		DiscountCode *PriceRuleDiscountCode `json:"discount_code"`
+	})
+	err := s.client.Get(path, resource, options)
+	return resource.DiscountCode, err
+}
+
+// CreateBatch starts a job creating up to DiscountCodeBatchLimit codes for a
+// price rule. The codes are created asynchronously, use WaitForBatch or
+// GetBatch to follow the job and ListBatchCodes for the codes that failed.
+// Use CreateBatches for more codes.
+func (s *DiscountCodeServiceOp) CreateBatch(priceRuleID int64, codes []string) (*DiscountCodeCreation, error) {
+	if len(codes) > DiscountCodeBatchLimit {
+		return nil, fmt.Errorf("a batch takes at most %d discount codes, got %d", DiscountCodeBatchLimit, len(codes))
+	}
+
+	discountCodes := make([]PriceRuleDiscountCode, len(codes))
+	for i, code := range codes {
+		discountCodes[i] = PriceRuleDiscountCode{Code: code}
+	}
+
+	path := fmt.Sprintf("%s/%d/batch.json", priceRulesBasePath, priceRuleID)
+	data := map[string][]PriceRuleDiscountCode{"discount_codes": discountCodes}
+	resource := new(struct {
+		DiscountCodeCreation *DiscountCodeCreation `json:"discount_code_creation"`
+	})
+	err := s.client.Post(path, data, resource)
+	return resource.DiscountCodeCreation, err
+}
+
+// CreateBatches starts as many batch jobs as needed to create any number of
+// codes for a price rule, each with up to DiscountCodeBatchLimit codes, and
+// returns the batches in the order of the codes. If starting a batch fails,
+// the batches started before it are returned along with the error, so that
+// they can still be followed.
+func (s *DiscountCodeServiceOp) CreateBatches(priceRuleID int64, codes []string) ([]DiscountCodeCreation, error) {
+	var batches []DiscountCodeCreation
+	for start := 0; start < len(codes); start += DiscountCodeBatchLimit {
+		end := start + DiscountCodeBatchLimit
+		if end > len(codes) {
+			end = len(codes)
+		}
+		batch, err := s.CreateBatch(priceRuleID, codes[start:end])
+		if err != nil {
+			return batches, err
+		}
+		batches = append(batches, *batch)
+	}
+	return batches, nil
+}
+
+// GetBatch returns the status of a discount code batch
+func (s *DiscountCodeServiceOp) GetBatch(priceRuleID, batchID int64) (*DiscountCodeCreation, error) {
+	return s.batches(priceRuleID).Get(batchID, nil)
+}
+
+// ListBatchCodes lists the codes of a discount code batch. The codes that
+// failed to be created have no ID and report why in their Errors.
+func (s *DiscountCodeServiceOp) ListBatchCodes(priceRuleID, batchID int64) ([]PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf("%s/%d/batch/%d/discount_codes", priceRulesBasePath, priceRuleID, batchID)
+	return NewResource[PriceRuleDiscountCode](s.client, path, "discount_code", "discount_codes").List(nil)
+}
+
+// WaitForBatch polls a discount code batch every interval until it is
+// completed. It stops polling when ctx is done, e.g. after the timeout of
+// context.WithTimeout, and returns the last status of the batch along with
+// an error wrapping the error of ctx. The interval must be positive.
+func (s *DiscountCodeServiceOp) WaitForBatch(ctx context.Context, priceRuleID, batchID int64, interval time.Duration) (*DiscountCodeCreation, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid polling interval %s for discount code batch %d", interval, batchID)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("discount code batch %d: %w", batchID, err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		batch, err := s.GetBatch(priceRuleID, batchID)
+		if err != nil {
+			return nil, err
+		}
+		if batch.Status == DiscountCodeCreationStatusCompleted {
+			return batch, nil
+		}
+		select {
+		case <-ctx.Done():
+			return batch, fmt.Errorf("discount code batch %d is still %s: %w", batchID, batch.Status, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,343 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	httpmock "github.com/jarcoal/httpmock"
+)
+
+func discountCodeTests(t *testing.T, discountCode PriceRuleDiscountCode) {
+	// Check that the ID is assigned to the returned discount code
+	expectedID := int64(507328175)
+	if discountCode.ID != expectedID {
+		t.Errorf("DiscountCode.ID returned %+v, expected %+v", discountCode.ID, expectedID)
+	}
+
+	expectedCode := "SUMMERSALE10OFF"
+	if discountCode.Code != expectedCode {
+		t.Errorf("DiscountCode.Code returned %+v, expected %+v", discountCode.Code, expectedCode)
+	}
+
+	expectedPriceRuleID := int64(507328175)
+	if discountCode.PriceRuleID != expectedPriceRuleID {
+		t.Errorf("DiscountCode.PriceRuleID returned %+v, expected %+v", discountCode.PriceRuleID, expectedPriceRuleID)
+	}
+}
+
+func TestDiscountCodeList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes.json",
+		httpmock.NewBytesResponder(200, loadFixture("discount_codes.json")))
+
+	discountCodes, err := client.DiscountCode.List(507328175, nil)
+	if err != nil {
+		t.Errorf("DiscountCode.List returned error: %v", err)
+	}
+
+	if len(discountCodes) != 1 {
+		t.Fatalf("DiscountCode.List returned %d discount codes, expected 1", len(discountCodes))
+	}
+	discountCodeTests(t, discountCodes[0])
+}
+
+func TestDiscountCodeCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/discount_codes/count.json",
+		httpmock.NewStringResponder(200, `{"count": 5}`))
+
+	cnt, err := client.DiscountCode.Count(nil)
+	if err != nil {
+		t.Errorf("DiscountCode.Count returned error: %v", err)
+	}
+
+	expected := 5
+	if cnt != expected {
+		t.Errorf("DiscountCode.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestDiscountCodeGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes/507328175.json",
+		httpmock.NewBytesResponder(200, loadFixture("discount_code.json")))
+
+	discountCode, err := client.DiscountCode.Get(507328175, 507328175, nil)
+	if err != nil {
+		t.Errorf("DiscountCode.Get returned error: %v", err)
+	}
+
+	discountCodeTests(t, *discountCode)
+}
+
+func TestDiscountCodeCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes.json",
+		bodyCheckingResponder(t,
+			`{"discount_code": {"code": "SUMMERSALE10OFF"}}`,
+			201,
+			loadFixture("discount_code.json")))
+
+	created, err := client.DiscountCode.Create(507328175, PriceRuleDiscountCode{Code: "SUMMERSALE10OFF"})
+	if err != nil {
+		t.Errorf("DiscountCode.Create returned error: %v", err)
+	}
+
+	discountCodeTests(t, *created)
+}
+
+func TestDiscountCodeUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes/507328175.json",
+		bodyCheckingResponder(t,
+			`{"discount_code": {"id": 507328175, "code": "WINTERSALE20OFF"}}`,
+			200,
+			[]byte(`{"discount_code": {"id": 507328175, "code": "WINTERSALE20OFF"}}`)))
+
+	discountCode := PriceRuleDiscountCode{ID: 507328175, Code: "WINTERSALE20OFF"}
+
+	updated, err := client.DiscountCode.Update(507328175, discountCode)
+	if err != nil {
+		t.Errorf("DiscountCode.Update returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(updated, &discountCode) {
+		t.Errorf("DiscountCode.Update returned %+v, expected %+v", updated, discountCode)
+	}
+}
+
+func TestDiscountCodeDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes/507328175.json",
+		httpmock.NewStringResponder(204, ""))
+
+	err := client.DiscountCode.Delete(507328175, 507328175)
+	if err != nil {
+		t.Errorf("DiscountCode.Delete returned error: %v", err)
+	}
+}
+
+func TestDiscountCodeLookup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/discount_codes/lookup.json?code=SUMMERSALE10OFF",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(303, "")
+			resp.Header.Set("Location", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes/507328175.json")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/discount_codes/507328175.json",
+		httpmock.NewBytesResponder(200, loadFixture("discount_code.json")))
+
+	discountCode, err := client.DiscountCode.Lookup("SUMMERSALE10OFF")
+	if err != nil {
+		t.Fatalf("DiscountCode.Lookup returned error: %v", err)
+	}
+
+	discountCodeTests(t, *discountCode)
+}
+
+func TestDiscountCodeCreateBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/price_rules/507328175/batch.json",
+		bodyCheckingResponder(t,
+			`{"discount_codes": [{"code": "SUMMER1"}, {"code": "SUMMER2"}, {"code": "SUMMERSALE10OFF"}]}`,
+			201,
+			loadFixture("discount_code_creation.json")))
+
+	batch, err := client.DiscountCode.CreateBatch(507328175, []string{"SUMMER1", "SUMMER2", "SUMMERSALE10OFF"})
+	if err != nil {
+		t.Fatalf("DiscountCode.CreateBatch returned error: %v", err)
+	}
+
+	expected := &DiscountCodeCreation{
+		ID:          989355119,
+		PriceRuleID: 507328175,
+		Status:      DiscountCodeCreationStatusQueued,
+		CodesCount:  3,
+		Logs:        []string{},
+	}
+	batch.CreatedAt, batch.UpdatedAt = nil, nil
+	if !reflect.DeepEqual(batch, expected) {
+		t.Errorf("DiscountCode.CreateBatch returned %+v, expected %+v", batch, expected)
+	}
+}
+
+func TestDiscountCodeCreateBatchLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	codes := make([]string, DiscountCodeBatchLimit+1)
+	for i := range codes {
+		codes[i] = strings.Repeat("A", i+1)
+	}
+
+	_, err := client.DiscountCode.CreateBatch(507328175, codes)
+	if err == nil {
+		t.Errorf("DiscountCode.CreateBatch of %d codes expected an error", len(codes))
+	}
+}
+
+func TestDiscountCodeCreateBatches(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var batchSizes []int
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/price_rules/507328175/batch.json",
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string][]PriceRuleDiscountCode{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return httpmock.NewStringResponse(400, `{"errors": "bad request"}`), nil
+			}
+			batchSizes = append(batchSizes, len(body["discount_codes"]))
+			return httpmock.NewStringResponse(201, fmt.Sprintf(
+				`{"discount_code_creation": {"id": %d, "price_rule_id": 507328175, "status": "queued", "codes_count": %d}}`,
+				len(batchSizes), len(body["discount_codes"]))), nil
+		})
+
+	codes := make([]string, 2*DiscountCodeBatchLimit+1)
+	for i := range codes {
+		codes[i] = fmt.Sprintf("SUMMER%d", i)
+	}
+
+	batches, err := client.DiscountCode.CreateBatches(507328175, codes)
+	if err != nil {
+		t.Fatalf("DiscountCode.CreateBatches returned error: %v", err)
+	}
+
+	expectedSizes := []int{DiscountCodeBatchLimit, DiscountCodeBatchLimit, 1}
+	if !reflect.DeepEqual(batchSizes, expectedSizes) {
+		t.Errorf("DiscountCode.CreateBatches sent batches of %v codes, expected %v", batchSizes, expectedSizes)
+	}
+
+	if len(batches) != 3 || batches[0].ID != 1 || batches[2].ID != 3 || batches[2].CodesCount != 1 {
+		t.Errorf("DiscountCode.CreateBatches returned %+v, expected the 3 batches in order", batches)
+	}
+}
+
+func TestDiscountCodeWaitForBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/batch/989355119.json",
+		httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewBytesResponse(200, loadFixture("discount_code_creation.json")),
+			httpmock.NewBytesResponse(200, loadFixture("discount_code_creation_completed.json")),
+		}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	batch, err := client.DiscountCode.WaitForBatch(ctx, 507328175, 989355119, time.Millisecond)
+	if err != nil {
+		t.Fatalf("DiscountCode.WaitForBatch returned error: %v", err)
+	}
+
+	if batch.Status != DiscountCodeCreationStatusCompleted || batch.ImportedCount != 2 || batch.FailedCount != 1 {
+		t.Errorf("DiscountCode.WaitForBatch returned %+v, expected a completed batch with 1 failed code", batch)
+	}
+
+	calls := httpmock.GetCallCountInfo()["GET https://fooshop.myshopify.com/admin/price_rules/507328175/batch/989355119.json"]
+	if calls != 2 {
+		t.Errorf("DiscountCode.WaitForBatch polled %d times, expected 2", calls)
+	}
+}
+
+func TestDiscountCodeWaitForBatchTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/batch/989355119.json",
+		httpmock.NewBytesResponder(200, loadFixture("discount_code_creation.json")))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	batch, err := client.DiscountCode.WaitForBatch(ctx, 507328175, 989355119, time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("DiscountCode.WaitForBatch returned error %v, expected a timeout error", err)
+	}
+
+	if batch == nil || batch.Status != DiscountCodeCreationStatusQueued {
+		t.Errorf("DiscountCode.WaitForBatch returned %+v, expected the queued batch", batch)
+	}
+}
+
+func TestDiscountCodeWaitForBatchInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/batch/989355119.json",
+		httpmock.NewBytesResponder(200, loadFixture("discount_code_creation.json")))
+
+	_, err := client.DiscountCode.WaitForBatch(context.Background(), 507328175, 989355119, 0)
+	if err == nil {
+		t.Errorf("DiscountCode.WaitForBatch with a zero interval expected an error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	batch, err := client.DiscountCode.WaitForBatch(ctx, 507328175, 989355119, time.Millisecond)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("DiscountCode.WaitForBatch returned error %v, expected a cancellation error", err)
+	}
+	if batch != nil {
+		t.Errorf("DiscountCode.WaitForBatch returned %+v, expected nil", batch)
+	}
+
+	calls := httpmock.GetCallCountInfo()["GET https://fooshop.myshopify.com/admin/price_rules/507328175/batch/989355119.json"]
+	if calls != 0 {
+		t.Errorf("DiscountCode.WaitForBatch polled %d times, expected 0", calls)
+	}
+}
+
+func TestDiscountCodeListBatchCodes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175/batch/989355119/discount_codes.json",
+		httpmock.NewBytesResponder(200, loadFixture("discount_code_batch_codes.json")))
+
+	discountCodes, err := client.DiscountCode.ListBatchCodes(507328175, 989355119)
+	if err != nil {
+		t.Fatalf("DiscountCode.ListBatchCodes returned error: %v", err)
+	}
+
+	if len(discountCodes) != 3 {
+		t.Fatalf("DiscountCode.ListBatchCodes returned %d discount codes, expected 3", len(discountCodes))
+	}
+
+	if discountCodes[0].ID != 1054381139 || len(discountCodes[0].Errors) != 0 {
+		t.Errorf("DiscountCode.ListBatchCodes returned %+v, expected a created code", discountCodes[0])
+	}
+
+	failed := discountCodes[2]
+	expectedErrors := map[string][]string{"code": {"must be unique. Please try a different code."}}
+	if failed.ID != 0 || !reflect.DeepEqual(failed.Errors, expectedErrors) {
+		t.Errorf("DiscountCode.ListBatchCodes returned %+v, expected errors %+v", failed, expectedErrors)
+	}
+}
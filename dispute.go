@@ -0,0 +1,80 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DisputeService is an interface for interfacing with the Shopify Payments
+// disputes endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/shopify_payments/dispute
+type DisputeService interface {
+	List(interface{}) ([]Dispute, error)
+	Get(int64, interface{}) (*Dispute, error)
+}
+
+// DisputeServiceOp handles communication with the Shopify Payments dispute
+// related methods of the Shopify API.
+type DisputeServiceOp struct {
+	client *Client
+}
+
+// Types of disputes
+const (
+	DisputeTypeChargeback = "chargeback"
+	DisputeTypeInquiry    = "inquiry"
+)
+
+// Statuses of disputes
+const (
+	DisputeStatusNeedsResponse  = "needs_response"
+	DisputeStatusUnderReview    = "under_review"
+	DisputeStatusChargeRefunded = "charge_refunded"
+	DisputeStatusAccepted       = "accepted"
+	DisputeStatusWon            = "won"
+	DisputeStatusLost           = "lost"
+)
+
+// Dispute represents a dispute of a Shopify Payments charge by the customer
+// with their bank.
+type Dispute struct {
+	ID                int64            `json:"id,omitempty"`
+	OrderID           int64            `json:"order_id,omitempty"`
+	Type              string           `json:"type,omitempty"`
+	Status            string           `json:"status,omitempty"`
+	Reason            string           `json:"reason,omitempty"`
+	NetworkReasonCode string           `json:"network_reason_code,omitempty"`
+	Currency          string           `json:"currency,omitempty"`
+	Amount            *decimal.Decimal `json:"amount,omitempty"`
+	EvidenceDueBy     *time.Time       `json:"evidence_due_by,omitempty"`
+	EvidenceSentOn    *time.Time       `json:"evidence_sent_on,omitempty"`
+	FinalizedOn       *time.Time       `json:"finalized_on,omitempty"`
+	InitiatedAt       *time.Time       `json:"initiated_at,omitempty"`
+}
+
+// DisputeListOptions filters the disputes to list by their status, one of
+// the DisputeStatus constants, and by the date they were initiated, e.g.
+// InitiatedAt: "2013-05-03"
+type DisputeListOptions struct {
+	ShopifyPaymentsListOptions
+	Status      string `url:"status,omitempty"`
+	InitiatedAt string `url:"initiated_at,omitempty"`
+}
+
+// disputes returns the resource for the disputes endpoints
+func (s *DisputeServiceOp) disputes() *Resource[Dispute] {
+	path := fmt.Sprintf("%s/disputes", shopifyPaymentsBasePath)
+	return NewResource[Dispute](s.client, path, "dispute", "disputes")
+}
+
+// List gets the disputes, most recent first.
+func (s *DisputeServiceOp) List(options interface{}) ([]Dispute, error) {
+	return s.disputes().List(options)
+}
+
+// Get gets individual dispute.
+func (s *DisputeServiceOp) Get(disputeID int64, options interface{}) (*Dispute, error) {
+	return s.disputes().Get(disputeID, options)
+}
@@ -0,0 +1,71 @@
+package goshopify
+
+import (
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func disputeTests(t *testing.T, dispute Dispute) {
+	expectedID := int64(598735659)
+	if dispute.ID != expectedID {
+		t.Errorf("Dispute.ID returned %+v, expected %+v", dispute.ID, expectedID)
+	}
+
+	if dispute.Type != DisputeTypeChargeback {
+		t.Errorf("Dispute.Type returned %+v, expected %+v", dispute.Type, DisputeTypeChargeback)
+	}
+
+	if dispute.Status != DisputeStatusNeedsResponse {
+		t.Errorf("Dispute.Status returned %+v, expected %+v", dispute.Status, DisputeStatusNeedsResponse)
+	}
+
+	expectedAmount := decimal.RequireFromString("11.50")
+	if dispute.Amount == nil || !dispute.Amount.Equals(expectedAmount) {
+		t.Errorf("Dispute.Amount returned %+v, expected %+v", dispute.Amount, expectedAmount)
+	}
+
+	if dispute.EvidenceDueBy == nil || dispute.EvidenceSentOn != nil {
+		t.Errorf("Dispute.EvidenceDueBy and EvidenceSentOn returned %v and %v, expected a due date only",
+			dispute.EvidenceDueBy, dispute.EvidenceSentOn)
+	}
+}
+
+func TestDisputeList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/shopify_payments/disputes.json?since_id=1&status=needs_response",
+		httpmock.NewBytesResponder(200, loadFixture("disputes.json")))
+
+	options := DisputeListOptions{
+		ShopifyPaymentsListOptions: ShopifyPaymentsListOptions{SinceID: 1},
+		Status:                     DisputeStatusNeedsResponse,
+	}
+	disputes, err := client.Dispute.List(options)
+	if err != nil {
+		t.Fatalf("Dispute.List returned error: %v", err)
+	}
+
+	if len(disputes) != 2 {
+		t.Fatalf("Dispute.List returned %d disputes, expected 2", len(disputes))
+	}
+	disputeTests(t, disputes[0])
+}
+
+func TestDisputeGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/shopify_payments/disputes/598735659.json?fields=id%2Ctype%2Cstatus%2Camount%2Cevidence_due_by%2Cevidence_sent_on",
+		httpmock.NewBytesResponder(200, loadFixture("dispute.json")))
+
+	options := ListOptions{Fields: "id,type,status,amount,evidence_due_by,evidence_sent_on"}
+	dispute, err := client.Dispute.Get(598735659, options)
+	if err != nil {
+		t.Fatalf("Dispute.Get returned error: %v", err)
+	}
+
+	disputeTests(t, *dispute)
+}
@@ -0,0 +1,158 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const draftOrdersBasePath = "admin/draft_orders"
+
+// DraftOrderService is an interface for interfacing with the draft orders
+// endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/draftorder
+type DraftOrderService interface {
+	List(interface{}) ([]DraftOrder, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*DraftOrder, error)
+	Create(DraftOrder) (*DraftOrder, error)
+	Update(DraftOrder) (*DraftOrder, error)
+	Delete(int64) error
+	SendInvoice(int64, DraftOrderInvoice) (*DraftOrderInvoice, error)
+	Complete(int64, bool) (*DraftOrder, error)
+}
+
+// DraftOrderServiceOp handles communication with the draft order related
+// methods of the Shopify API.
+type DraftOrderServiceOp struct {
+	client *Client
+}
+
+// Statuses of a draft order
+const (
+	DraftOrderStatusOpen        = "open"
+	DraftOrderStatusInvoiceSent = "invoice_sent"
+	DraftOrderStatusCompleted   = "completed"
+)
+
+// Value types of an applied discount
+const (
+	AppliedDiscountValueTypeFixedAmount = "fixed_amount"
+	AppliedDiscountValueTypePercentage  = "percentage"
+)
+
+// A struct for all available draft order list options.
+// See: https://help.shopify.com/api/reference/draftorder#index
+type DraftOrderListOptions struct {
+	Limit        int       `url:"limit,omitempty"`
+	SinceID      int64     `url:"since_id,omitempty"`
+	UpdatedAtMin time.Time `url:"updated_at_min,omitempty"`
+	UpdatedAtMax time.Time `url:"updated_at_max,omitempty"`
+	IDs          []int64   `url:"ids,omitempty,comma"`
+	Status       string    `url:"status,omitempty"`
+	Fields       string    `url:"fields,omitempty"`
+}
+
+// DraftOrder represents a Shopify draft order
+type DraftOrder struct {
+	ID                        int64            `json:"id,omitempty"`
+	OrderID                   int64            `json:"order_id,omitempty"`
+	Name                      string           `json:"name,omitempty"`
+	Email                     string           `json:"email,omitempty"`
+	Customer                  *Customer        `json:"customer,omitempty"`
+	UseCustomerDefaultAddress bool             `json:"use_customer_default_address,omitempty"`
+	ShippingAddress           *Address         `json:"shipping_address,omitempty"`
+	BillingAddress            *Address         `json:"billing_address,omitempty"`
+	Note                      string           `json:"note,omitempty"`
+	NoteAttributes            []NoteAttribute  `json:"note_attributes,omitempty"`
+	Currency                  string           `json:"currency,omitempty"`
+	InvoiceSentAt             *time.Time       `json:"invoice_sent_at,omitempty"`
+	InvoiceURL                string           `json:"invoice_url,omitempty"`
+	LineItems                 []LineItem       `json:"line_items,omitempty"`
+	ShippingLine              *ShippingLines   `json:"shipping_line,omitempty"`
+	Tags                      string           `json:"tags,omitempty"`
+	TaxExempt                 bool             `json:"tax_exempt,omitempty"`
+	TaxesIncluded             bool             `json:"taxes_included,omitempty"`
+	TaxLines                  []TaxLine        `json:"tax_lines,omitempty"`
+	AppliedDiscount           *AppliedDiscount `json:"applied_discount,omitempty"`
+	SubtotalPrice             *decimal.Decimal `json:"subtotal_price,omitempty"`
+	TotalTax                  *decimal.Decimal `json:"total_tax,omitempty"`
+	TotalPrice                *decimal.Decimal `json:"total_price,omitempty"`
+	Status                    string           `json:"status,omitempty"`
+	CompletedAt               *time.Time       `json:"completed_at,omitempty"`
+	CreatedAt                 *time.Time       `json:"created_at,omitempty"`
+	UpdatedAt                 *time.Time       `json:"updated_at,omitempty"`
+	AdminGraphqlAPIID         string           `json:"admin_graphql_api_id,omitempty"`
+}
+
+// AppliedDiscount is a discount applied to a draft order or one of its line
+// items. ValueType is one of the AppliedDiscountValueType constants.
+type AppliedDiscount struct {
+	Title       string           `json:"title,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Value       *decimal.Decimal `json:"value,omitempty"`
+	ValueType   string           `json:"value_type,omitempty"`
+	Amount      *decimal.Decimal `json:"amount,omitempty"`
+}
+
+// DraftOrderInvoice is the invoice email of a draft order. Shopify uses the
+// default values for empty fields.
+type DraftOrderInvoice struct {
+	To            string   `json:"to,omitempty"`
+	From          string   `json:"from,omitempty"`
+	Subject       string   `json:"subject,omitempty"`
+	CustomMessage string   `json:"custom_message,omitempty"`
+	BCC           []string `json:"bcc,omitempty"`
+}
+
+// draftOrders returns the resource for the draft orders endpoints
+func (s *DraftOrderServiceOp) draftOrders() *Resource[DraftOrder] {
+	return NewResource[DraftOrder](s.client, draftOrdersBasePath, "draft_order", "draft_orders")
+}
+
+// List draft orders
+func (s *DraftOrderServiceOp) List(options interface{}) ([]DraftOrder, error) {
+	return s.draftOrders().List(options)
+}
+
+// Count draft orders
+func (s *DraftOrderServiceOp) Count(options interface{}) (int, error) {
+	return s.draftOrders().Count(options)
+}
+
+// Get individual draft order
+func (s *DraftOrderServiceOp) Get(draftOrderID int64, options interface{}) (*DraftOrder, error) {
+	return s.draftOrders().Get(draftOrderID, options)
+}
+
+// Create a new draft order
+func (s *DraftOrderServiceOp) Create(draftOrder DraftOrder) (*DraftOrder, error) {
+	return s.draftOrders().Create(draftOrder)
+}
+
+// Update an existing draft order
+func (s *DraftOrderServiceOp) Update(draftOrder DraftOrder) (*DraftOrder, error) {
+	return s.draftOrders().Update(draftOrder.ID, draftOrder)
+}
+
+// Delete an existing draft order
+func (s *DraftOrderServiceOp) Delete(draftOrderID int64) error {
+	return s.draftOrders().Delete(draftOrderID)
+}
+
+// SendInvoice sends an invoice for a draft order
+func (s *DraftOrderServiceOp) SendInvoice(draftOrderID int64, invoice DraftOrderInvoice) (*DraftOrderInvoice, error) {
+	invoices := NewResource[DraftOrderInvoice](s.client, draftOrdersBasePath, "draft_order_invoice", "")
+	wrappedData := map[string]DraftOrderInvoice{"draft_order_invoice": invoice}
+	return invoices.Action(draftOrderID, "send_invoice", wrappedData)
+}
+
+// Complete a draft order, which turns it into an order. With paymentPending
+// the order is marked as pending, otherwise as paid.
+func (s *DraftOrderServiceOp) Complete(draftOrderID int64, paymentPending bool) (*DraftOrder, error) {
+	options := struct {
+		PaymentPending bool `url:"payment_pending,omitempty"`
+	}{paymentPending}
+	return s.draftOrders().Do("PUT", fmt.Sprintf("%d/complete", draftOrderID), nil, options)
+}
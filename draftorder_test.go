@@ -0,0 +1,244 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func draftOrderTests(t *testing.T, draftOrder DraftOrder) {
+	// Check that the ID is assigned to the returned draft order
+	expectedID := int64(994118539)
+	if draftOrder.ID != expectedID {
+		t.Errorf("DraftOrder.ID returned %+v, expected %+v", draftOrder.ID, expectedID)
+	}
+
+	// Check that the customer and addresses are parsed
+	if draftOrder.Customer == nil || draftOrder.Customer.ID != 207119551 {
+		t.Errorf("DraftOrder.Customer returned %+v, expected customer %d", draftOrder.Customer, 207119551)
+	}
+	if draftOrder.ShippingAddress == nil || draftOrder.ShippingAddress.City != "Louisville" {
+		t.Errorf("DraftOrder.ShippingAddress returned %+v, expected city %s", draftOrder.ShippingAddress, "Louisville")
+	}
+
+	// Check the applied discount of the draft order
+	expectedDiscount := &AppliedDiscount{
+		Title:       "Wholesale",
+		Description: "10% off for resellers",
+		Value:       decimalPtr("10.0"),
+		ValueType:   AppliedDiscountValueTypePercentage,
+		Amount:      decimalPtr("19.90"),
+	}
+	if !reflect.DeepEqual(draftOrder.AppliedDiscount, expectedDiscount) {
+		t.Errorf("DraftOrder.AppliedDiscount returned %+v, expected %+v", draftOrder.AppliedDiscount, expectedDiscount)
+	}
+
+	// Check the custom line item and its applied discount
+	if len(draftOrder.LineItems) != 2 {
+		t.Fatalf("DraftOrder.LineItems returned %d items, expected 2", len(draftOrder.LineItems))
+	}
+	custom := draftOrder.LineItems[1]
+	if !custom.Custom {
+		t.Errorf("LineItem.Custom returned %+v, expected %+v", custom.Custom, true)
+	}
+	if custom.AppliedDiscount == nil || custom.AppliedDiscount.ValueType != AppliedDiscountValueTypePercentage {
+		t.Errorf("LineItem.AppliedDiscount returned %+v, expected a percentage discount", custom.AppliedDiscount)
+	}
+
+	expectedTotal := decimal.RequireFromString("221.04")
+	if draftOrder.TotalPrice == nil || !draftOrder.TotalPrice.Equals(expectedTotal) {
+		t.Errorf("DraftOrder.TotalPrice returned %+v, expected %+v", draftOrder.TotalPrice, expectedTotal)
+	}
+}
+
+func decimalPtr(s string) *decimal.Decimal {
+	d := decimal.RequireFromString(s)
+	return &d
+}
+
+func TestDraftOrderList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/draft_orders.json?status=open",
+		httpmock.NewBytesResponder(200, loadFixture("draft_orders.json")))
+
+	draftOrders, err := client.DraftOrder.List(DraftOrderListOptions{Status: DraftOrderStatusOpen})
+	if err != nil {
+		t.Errorf("DraftOrder.List returned error: %v", err)
+	}
+
+	if len(draftOrders) != 2 {
+		t.Fatalf("DraftOrder.List returned %d draft orders, expected 2", len(draftOrders))
+	}
+	if draftOrders[1].OrderID != 450789469 {
+		t.Errorf("DraftOrder.OrderID returned %+v, expected %+v", draftOrders[1].OrderID, 450789469)
+	}
+}
+
+func TestDraftOrderCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/draft_orders/count.json",
+		httpmock.NewStringResponder(200, `{"count": 3}`))
+
+	cnt, err := client.DraftOrder.Count(nil)
+	if err != nil {
+		t.Errorf("DraftOrder.Count returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("DraftOrder.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestDraftOrderGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/draft_orders/994118539.json",
+		httpmock.NewBytesResponder(200, loadFixture("draft_order.json")))
+
+	draftOrder, err := client.DraftOrder.Get(994118539, nil)
+	if err != nil {
+		t.Errorf("DraftOrder.Get returned error: %v", err)
+	}
+
+	draftOrderTests(t, *draftOrder)
+}
+
+func TestDraftOrderCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/draft_orders.json",
+		httpmock.NewBytesResponder(201, loadFixture("draft_order.json")))
+
+	draftOrder := DraftOrder{
+		Customer: &Customer{ID: 207119551},
+		LineItems: []LineItem{
+			{VariantID: 39072856, Quantity: 1},
+			{
+				Title:    "Custom engraving",
+				Price:    decimalPtr("20.00"),
+				Quantity: 1,
+				AppliedDiscount: &AppliedDiscount{
+					Value:     decimalPtr("100.0"),
+					ValueType: AppliedDiscountValueTypePercentage,
+				},
+			},
+		},
+		UseCustomerDefaultAddress: true,
+	}
+
+	created, err := client.DraftOrder.Create(draftOrder)
+	if err != nil {
+		t.Errorf("DraftOrder.Create returned error: %v", err)
+	}
+
+	draftOrderTests(t, *created)
+}
+
+func TestDraftOrderUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/draft_orders/994118539.json",
+		httpmock.NewBytesResponder(200, loadFixture("draft_order.json")))
+
+	draftOrder := DraftOrder{
+		ID:   994118539,
+		Note: "rush order",
+	}
+
+	updated, err := client.DraftOrder.Update(draftOrder)
+	if err != nil {
+		t.Errorf("DraftOrder.Update returned error: %v", err)
+	}
+
+	draftOrderTests(t, *updated)
+}
+
+func TestDraftOrderDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/draft_orders/994118539.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.DraftOrder.Delete(994118539)
+	if err != nil {
+		t.Errorf("DraftOrder.Delete returned error: %v", err)
+	}
+}
+
+func TestDraftOrderSendInvoice(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/draft_orders/994118539/send_invoice.json",
+		func(req *http.Request) (*http.Response, error) {
+			body := make(map[string]DraftOrderInvoice)
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return httpmock.NewStringResponse(400, `{"errors": "bad request"}`), nil
+			}
+			if _, ok := body["draft_order_invoice"]; !ok {
+				return httpmock.NewStringResponse(422, `{"errors": "missing draft_order_invoice"}`), nil
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("draft_order_invoice.json")), nil
+		})
+
+	invoice := DraftOrderInvoice{
+		To:            "first@example.com",
+		From:          "steve@apple.com",
+		Subject:       "Apple Computer Invoice",
+		CustomMessage: "Thank you for ordering!",
+		BCC:           []string{"steve@apple.com"},
+	}
+
+	sent, err := client.DraftOrder.SendInvoice(994118539, invoice)
+	if err != nil {
+		t.Errorf("DraftOrder.SendInvoice returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(sent, &invoice) {
+		t.Errorf("DraftOrder.SendInvoice returned %+v, expected %+v", sent, invoice)
+	}
+}
+
+func TestDraftOrderComplete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/draft_orders/994118539/complete.json",
+		httpmock.NewStringResponder(200, `{"draft_order": {"id": 994118539, "order_id": 1, "status": "completed"}}`))
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/draft_orders/994118539/complete.json?payment_pending=true",
+		httpmock.NewStringResponder(200, `{"draft_order": {"id": 994118539, "order_id": 2, "status": "completed"}}`))
+
+	draftOrder, err := client.DraftOrder.Complete(994118539, false)
+	if err != nil {
+		t.Errorf("DraftOrder.Complete returned error: %v", err)
+	}
+
+	expected := &DraftOrder{ID: 994118539, OrderID: 1, Status: DraftOrderStatusCompleted}
+	if !reflect.DeepEqual(draftOrder, expected) {
+		t.Errorf("DraftOrder.Complete returned %+v, expected %+v", draftOrder, expected)
+	}
+
+	draftOrder, err = client.DraftOrder.Complete(994118539, true)
+	if err != nil {
+		t.Errorf("DraftOrder.Complete returned error: %v", err)
+	}
+
+	expected.OrderID = 2
+	if !reflect.DeepEqual(draftOrder, expected) {
+		t.Errorf("DraftOrder.Complete returned %+v, expected %+v", draftOrder, expected)
+	}
+}
@@ -0,0 +1,132 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+// EventService is an interface for interfacing with the events endpoints of
+// the Shopify API. Events record the actions taken on the resources of a
+// shop, e.g. the deletion of a product.
+// See: https://help.shopify.com/api/reference/events/event
+type EventService interface {
+	List(interface{}) ([]Event, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*Event, error)
+}
+
+// EventsService is an interface for other Shopify resources to interface
+// with the events endpoints of the Shopify API. Shopify only lists the
+// events of a single product or order, counting and getting events is done
+// with EventService.
+// See: https://help.shopify.com/api/reference/events/event
+type EventsService interface {
+	ListEvents(int64, interface{}) ([]Event, error)
+}
+
+// EventServiceOp handles communication with the event related methods of
+// the Shopify API.
+type EventServiceOp struct {
+	client     *Client
+	resource   string
+	resourceID int64
+}
+
+// Types of the subjects of events
+const (
+	EventSubjectTypeArticle       = "Article"
+	EventSubjectTypeBlog          = "Blog"
+	EventSubjectTypeCollection    = "Collection"
+	EventSubjectTypeComment       = "Comment"
+	EventSubjectTypeOrder         = "Order"
+	EventSubjectTypePage          = "Page"
+	EventSubjectTypePriceRule     = "PriceRule"
+	EventSubjectTypeProduct       = "Product"
+	EventSubjectTypeApiPermission = "ApiPermission"
+)
+
+// Verbs of common events. The possible verbs depend on the subject type,
+// e.g. only orders are placed.
+const (
+	EventVerbCreate      = "create"
+	EventVerbDestroy     = "destroy"
+	EventVerbUpdate      = "update"
+	EventVerbPublished   = "published"
+	EventVerbUnpublished = "unpublished"
+	EventVerbPlaced      = "placed"
+	EventVerbConfirmed   = "confirmed"
+	EventVerbClosed      = "closed"
+	EventVerbReOpened    = "re_opened"
+)
+
+// Event represents a Shopify event. SubjectType is one of the
+// EventSubjectType constants, see SubjectGID for resolving the subject.
+type Event struct {
+	ID          int64       `json:"id,omitempty"`
+	SubjectID   int64       `json:"subject_id,omitempty"`
+	SubjectType string      `json:"subject_type,omitempty"`
+	Verb        string      `json:"verb,omitempty"`
+	Arguments   interface{} `json:"arguments,omitempty"`
+	Body        string      `json:"body,omitempty"`
+	Message     string      `json:"message,omitempty"`
+	Author      string      `json:"author,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Path        string      `json:"path,omitempty"`
+	CreatedAt   *time.Time  `json:"created_at,omitempty"`
+}
+
+// EventListOptions filters the events to list by their verb, one of the
+// EventVerb constants, and by the types of their subjects, e.g.
+// Filter: "Product,Order"
+type EventListOptions struct {
+	ListOptions
+	Filter string `url:"filter,omitempty"`
+	Verb   string `url:"verb,omitempty"`
+}
+
+// eventSubjectGIDs maps the subject types of events to the kinds of the
+// GraphQL global IDs of the subjects
+var eventSubjectGIDs = map[string]string{
+	EventSubjectTypeArticle:    GIDArticle,
+	EventSubjectTypeBlog:       GIDBlog,
+	EventSubjectTypeCollection: GIDCollection,
+	EventSubjectTypeComment:    GIDComment,
+	EventSubjectTypeOrder:      GIDOrder,
+	EventSubjectTypePage:       GIDPage,
+	EventSubjectTypePriceRule:  GIDPriceRule,
+	EventSubjectTypeProduct:    GIDProduct,
+}
+
+// SubjectGID returns the GraphQL global ID of the subject of the event, e.g.
+// gid://shopify/Product/123 for the event of a product. Its Resource is one
+// of the GID constants and its ID is the ID for the REST service of the
+// subject, e.g. ProductService.
+func (e Event) SubjectGID() (GID, error) {
+	resource, ok := eventSubjectGIDs[e.SubjectType]
+	if !ok {
+		return GID{}, fmt.Errorf("event %d has an unsupported subject type %q", e.ID, e.SubjectType)
+	}
+	return NewGID(resource, e.SubjectID), nil
+}
+
+// events returns the resource for the events endpoints, scoped to the
+// resource of the service if it has one.
+func (s *EventServiceOp) events() *Resource[Event] {
+	prefix := EventPathPrefix(s.resource, s.resourceID)
+	return NewResource[Event](s.client, prefix, "event", "events")
+}
+
+// List events
+func (s *EventServiceOp) List(options interface{}) ([]Event, error) {
+	return s.events().List(options)
+}
+
+// Count events
+func (s *EventServiceOp) Count(options interface{}) (int, error) {
+	return s.events().Count(options)
+}
+
+// Get individual event
+func (s *EventServiceOp) Get(eventID int64, options interface{}) (*Event, error) {
+	return s.events().Get(eventID, options)
+}
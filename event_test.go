@@ -0,0 +1,122 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+)
+
+func eventTests(t *testing.T, event Event) {
+	expectedID := int64(164748010)
+	if event.ID != expectedID {
+		t.Errorf("Event.ID returned %+v, expected %+v", event.ID, expectedID)
+	}
+
+	expectedSubjectID := int64(632910392)
+	if event.SubjectID != expectedSubjectID {
+		t.Errorf("Event.SubjectID returned %+v, expected %+v", event.SubjectID, expectedSubjectID)
+	}
+
+	if event.SubjectType != EventSubjectTypeProduct {
+		t.Errorf("Event.SubjectType returned %+v, expected %+v", event.SubjectType, EventSubjectTypeProduct)
+	}
+
+	if event.Verb != EventVerbDestroy {
+		t.Errorf("Event.Verb returned %+v, expected %+v", event.Verb, EventVerbDestroy)
+	}
+
+	expectedArguments := []interface{}{"IPod Nano - 8GB"}
+	if !reflect.DeepEqual(event.Arguments, expectedArguments) {
+		t.Errorf("Event.Arguments returned %+v, expected %+v", event.Arguments, expectedArguments)
+	}
+
+	expectedAuthor := "Shopify"
+	if event.Author != expectedAuthor {
+		t.Errorf("Event.Author returned %+v, expected %+v", event.Author, expectedAuthor)
+	}
+
+	if event.CreatedAt == nil || event.CreatedAt.Year() != 2008 {
+		t.Errorf("Event.CreatedAt returned %v, expected 2008-01-10", event.CreatedAt)
+	}
+}
+
+func TestEventList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/events.json?filter=Product%2COrder&verb=destroy",
+		httpmock.NewBytesResponder(200, loadFixture("events.json")))
+
+	options := EventListOptions{Filter: "Product,Order", Verb: EventVerbDestroy}
+	events, err := client.Event.List(options)
+	if err != nil {
+		t.Fatalf("Event.List returned error: %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("Event.List returned %d events, expected 2", len(events))
+	}
+	eventTests(t, events[0])
+}
+
+func TestEventCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/events/count.json",
+		httpmock.NewStringResponder(200, `{"count": 3}`))
+
+	cnt, err := client.Event.Count(nil)
+	if err != nil {
+		t.Errorf("Event.Count returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("Event.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestEventGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/events/164748010.json",
+		httpmock.NewBytesResponder(200, loadFixture("event.json")))
+
+	event, err := client.Event.Get(164748010, nil)
+	if err != nil {
+		t.Fatalf("Event.Get returned error: %v", err)
+	}
+
+	eventTests(t, *event)
+}
+
+func TestEventSubjectGID(t *testing.T) {
+	cases := []struct {
+		event    Event
+		expected string
+	}{
+		{Event{SubjectType: EventSubjectTypeProduct, SubjectID: 632910392}, "gid://shopify/Product/632910392"},
+		{Event{SubjectType: EventSubjectTypeOrder, SubjectID: 450789469}, "gid://shopify/Order/450789469"},
+		{Event{SubjectType: EventSubjectTypeArticle, SubjectID: 134645308}, "gid://shopify/OnlineStoreArticle/134645308"},
+		{Event{SubjectType: EventSubjectTypePriceRule, SubjectID: 507328175}, "gid://shopify/PriceRule/507328175"},
+	}
+
+	for _, c := range cases {
+		gid, err := c.event.SubjectGID()
+		if err != nil {
+			t.Errorf("Event.SubjectGID of a %s returned error: %v", c.event.SubjectType, err)
+			continue
+		}
+		if gid.String() != c.expected {
+			t.Errorf("Event.SubjectGID of a %s returned %s, expected %s", c.event.SubjectType, gid, c.expected)
+		}
+	}
+
+	_, err := Event{ID: 1, SubjectType: EventSubjectTypeApiPermission, SubjectID: 2}.SubjectGID()
+	if err == nil {
+		t.Errorf("Event.SubjectGID of an ApiPermission expected an error")
+	}
+}
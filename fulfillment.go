@@ -1,9 +1,6 @@
 package goshopify
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
 
 // FulfillmentService is an interface for interfacing with the fulfillment endpoints
 // of the Shopify API.
@@ -11,26 +8,26 @@ import (
 type FulfillmentService interface {
 	List(interface{}) ([]Fulfillment, error)
 	Count(interface{}) (int, error)
-	Get(int, interface{}) (*Fulfillment, error)
+	Get(int64, interface{}) (*Fulfillment, error)
 	Create(Fulfillment) (*Fulfillment, error)
 	Update(Fulfillment) (*Fulfillment, error)
-	Complete(int) (*Fulfillment, error)
-	Transition(int) (*Fulfillment, error)
-	Cancel(int) (*Fulfillment, error)
+	Complete(int64) (*Fulfillment, error)
+	Transition(int64) (*Fulfillment, error)
+	Cancel(int64) (*Fulfillment, error)
 }
 
 // FulfillmentsService is an interface for other Shopify resources
 // to interface with the fulfillment endpoints of the Shopify API.
 // https://help.shopify.com/api/reference/fulfillment
 type FulfillmentsService interface {
-	ListFulfillments(int, interface{}) ([]Fulfillment, error)
-	CountFulfillments(int, interface{}) (int, error)
-	GetFulfillment(int, int, interface{}) (*Fulfillment, error)
-	CreateFulfillment(int, Fulfillment) (*Fulfillment, error)
-	UpdateFulfillment(int, Fulfillment) (*Fulfillment, error)
-	CompleteFulfillment(int, int) (*Fulfillment, error)
-	TransitionFulfillment(int, int) (*Fulfillment, error)
-	CancelFulfillment(int, int) (*Fulfillment, error)
+	ListFulfillments(int64, interface{}) ([]Fulfillment, error)
+	CountFulfillments(int64, interface{}) (int, error)
+	GetFulfillment(int64, int64, interface{}) (*Fulfillment, error)
+	CreateFulfillment(int64, Fulfillment) (*Fulfillment, error)
+	UpdateFulfillment(int64, Fulfillment) (*Fulfillment, error)
+	CompleteFulfillment(int64, int64) (*Fulfillment, error)
+	TransitionFulfillment(int64, int64) (*Fulfillment, error)
+	CancelFulfillment(int64, int64) (*Fulfillment, error)
 }
 
 // FulfillmentServiceOp handles communication with the fulfillment
@@ -38,27 +35,38 @@ type FulfillmentsService interface {
 type FulfillmentServiceOp struct {
 	client     *Client
 	resource   string
-	resourceID int
+	resourceID int64
 }
 
 // Fulfillment represents a Shopify fulfillment.
 type Fulfillment struct {
-	ID              int        `json:"id,omitempty"`
-	OrderID         int        `json:"order_id,omitempty"`
-	LocationID      int        `json:"location_id,omitempty"`
-	Status          string     `json:"status,omitempty"`
-	CreatedAt       *time.Time `json:"created_at,omitempty"`
-	Service         string     `json:"service,omitempty"`
-	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
-	TrackingCompany string     `json:"tracking_company,omitempty"`
-	ShipmentStatus  string     `json:"shipment_status,omitempty"`
-	TrackingNumber  string     `json:"tracking_number,omitempty"`
-	TrackingNumbers []string   `json:"tracking_numbers,omitempty"`
-	TrackingUrl     string     `json:"tracking_url,omitempty"`
-	TrackingUrls    []string   `json:"tracking_urls,omitempty"`
-	Receipt         Receipt    `json:"receipt,omitempty"`
-	LineItems       []LineItem `json:"line_items,omitempty"`
-	NotifyCustomer  bool       `json:"notify_customer,omitempty"`
+	ID                          int64                         `json:"id,omitempty"`
+	OrderID                     int64                         `json:"order_id,omitempty"`
+	LocationID                  int64                         `json:"location_id,omitempty"`
+	Status                      string                        `json:"status,omitempty"`
+	CreatedAt                   *time.Time                    `json:"created_at,omitempty"`
+	Service                     string                        `json:"service,omitempty"`
+	UpdatedAt                   *time.Time                    `json:"updated_at,omitempty"`
+	TrackingCompany             string                        `json:"tracking_company,omitempty"`
+	ShipmentStatus              string                        `json:"shipment_status,omitempty"`
+	TrackingNumber              string                        `json:"tracking_number,omitempty"`
+	TrackingNumbers             []string                      `json:"tracking_numbers,omitempty"`
+	TrackingUrl                 string                        `json:"tracking_url,omitempty"`
+	TrackingUrls                []string                      `json:"tracking_urls,omitempty"`
+	Receipt                     Receipt                       `json:"receipt,omitempty"`
+	LineItems                   []LineItem                    `json:"line_items,omitempty"`
+	NotifyCustomer              bool                          `json:"notify_customer,omitempty"`
+	LineItemsByFulfillmentOrder []LineItemsByFulfillmentOrder `json:"line_items_by_fulfillment_order,omitempty"`
+	TrackingInfo                *FulfillmentTrackingInfo      `json:"tracking_info,omitempty"`
+	AdminGraphqlAPIID           string                        `json:"admin_graphql_api_id,omitempty"`
+}
+
+// FulfillmentTrackingInfo is the tracking information of a fulfillment
+// created for fulfillment orders
+type FulfillmentTrackingInfo struct {
+	Number  string `json:"number,omitempty"`
+	URL     string `json:"url,omitempty"`
+	Company string `json:"company,omitempty"`
 }
 
 // Receipt represents a Shopify receipt.
@@ -68,83 +76,62 @@ type Receipt struct {
 }
 
 // FulfillmentResource represents the result from the fulfillments/X.json endpoint
+//
+// Deprecated: FulfillmentService returns and takes a Fulfillment directly.
 type FulfillmentResource struct {
 	Fulfillment *Fulfillment `json:"fulfillment"`
 }
 
 // FulfillmentsResource represents the result from the fullfilments.json endpoint
+//
+// Deprecated: FulfillmentService.List returns the fulfillments directly.
 type FulfillmentsResource struct {
 	Fulfillments []Fulfillment `json:"fulfillments"`
 }
 
+// fulfillments returns the resource for the fulfillments endpoints, scoped
+// to the resource of the service if it has one.
+func (s *FulfillmentServiceOp) fulfillments() *Resource[Fulfillment] {
+	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
+	return NewResource[Fulfillment](s.client, prefix, "fulfillment", "fulfillments")
+}
+
 // List fulfillments
 func (s *FulfillmentServiceOp) List(options interface{}) ([]Fulfillment, error) {
-	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s.json", prefix)
-	resource := new(FulfillmentsResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Fulfillments, err
+	return s.fulfillments().List(options)
 }
 
 // Count fulfillments
 func (s *FulfillmentServiceOp) Count(options interface{}) (int, error) {
-	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s/count.json", prefix)
-	return s.client.Count(path, options)
+	return s.fulfillments().Count(options)
 }
 
 // Get individual fulfillment
-func (s *FulfillmentServiceOp) Get(fulfillmentID int, options interface{}) (*Fulfillment, error) {
-	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s/%d.json", prefix, fulfillmentID)
-	resource := new(FulfillmentResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Fulfillment, err
+func (s *FulfillmentServiceOp) Get(fulfillmentID int64, options interface{}) (*Fulfillment, error) {
+	return s.fulfillments().Get(fulfillmentID, options)
 }
 
 // Create a new fulfillment
 func (s *FulfillmentServiceOp) Create(fulfillment Fulfillment) (*Fulfillment, error) {
-	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s.json", prefix)
-	wrappedData := FulfillmentResource{Fulfillment: &fulfillment}
-	resource := new(FulfillmentResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Fulfillment, err
+	return s.fulfillments().Create(fulfillment)
 }
 
 // Update an existing fulfillment
 func (s *FulfillmentServiceOp) Update(fulfillment Fulfillment) (*Fulfillment, error) {
-	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s/%d.json", prefix, fulfillment.ID)
-	wrappedData := FulfillmentResource{Fulfillment: &fulfillment}
-	resource := new(FulfillmentResource)
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.Fulfillment, err
+	return s.fulfillments().Update(fulfillment.ID, fulfillment)
 }
 
 // Complete an existing fulfillment
-func (s *FulfillmentServiceOp) Complete(fulfillmentID int) (*Fulfillment, error) {
-	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s/%d/complete.json", prefix, fulfillmentID)
-	resource := new(FulfillmentResource)
-	err := s.client.Post(path, nil, resource)
-	return resource.Fulfillment, err
+func (s *FulfillmentServiceOp) Complete(fulfillmentID int64) (*Fulfillment, error) {
+	return s.fulfillments().Action(fulfillmentID, "complete", nil)
 }
 
 // Transition an existing fulfillment
-func (s *FulfillmentServiceOp) Transition(fulfillmentID int) (*Fulfillment, error) {
-	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s/%d/open.json", prefix, fulfillmentID)
-	resource := new(FulfillmentResource)
-	err := s.client.Post(path, nil, resource)
-	return resource.Fulfillment, err
+func (s *FulfillmentServiceOp) Transition(fulfillmentID int64) (*Fulfillment, error) {
+	return s.fulfillments().Action(fulfillmentID, "open", nil)
 }
 
 // Cancel an existing fulfillment
-func (s *FulfillmentServiceOp) Cancel(fulfillmentID int) (*Fulfillment, error) {
-	prefix := FulfillmentPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s/%d/cancel.json", prefix, fulfillmentID)
-	resource := new(FulfillmentResource)
-	err := s.client.Post(path, nil, resource)
-	return resource.Fulfillment, err
+func (s *FulfillmentServiceOp) Cancel(fulfillmentID int64) (*Fulfillment, error) {
+	return s.fulfillments().Action(fulfillmentID, "cancel", nil)
 }
@@ -5,15 +5,21 @@ import (
 	"testing"
 	"time"
 
-	httpmock "gopkg.in/jarcoal/httpmock.v1"
+	httpmock "github.com/jarcoal/httpmock"
 )
 
 func FulfillmentTests(t *testing.T, fulfillment Fulfillment) {
 	// Check that ID is assigned to the returned fulfillment
-	expectedInt := 1022782888
+	expectedInt := int64(1022782888)
 	if fulfillment.ID != expectedInt {
 		t.Errorf("Fulfillment.ID returned %+v, expected %+v", fulfillment.ID, expectedInt)
 	}
+
+	// Check that the GraphQL ID matches the REST ID
+	expectedGID := NewGID(GIDFulfillment, expectedInt).String()
+	if fulfillment.AdminGraphqlAPIID != expectedGID {
+		t.Errorf("Fulfillment.AdminGraphqlAPIID returned %+v, expected %+v", fulfillment.AdminGraphqlAPIID, expectedGID)
+	}
 }
 
 func TestFulfillmentList(t *testing.T) {
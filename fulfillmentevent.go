@@ -0,0 +1,96 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+// FulfillmentEventService is an interface for interfacing with the
+// fulfillment events endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/shipping-and-fulfillment/fulfillmentevent
+type FulfillmentEventService interface {
+	List(int64, int64, interface{}) ([]FulfillmentEvent, error)
+	Get(int64, int64, int64, interface{}) (*FulfillmentEvent, error)
+	Create(int64, int64, FulfillmentEvent) (*FulfillmentEvent, error)
+	Delete(int64, int64, int64) error
+}
+
+// FulfillmentEventServiceOp handles communication with the fulfillment event
+// related methods of the Shopify API.
+type FulfillmentEventServiceOp struct {
+	client *Client
+}
+
+// Statuses of a fulfillment event
+const (
+	FulfillmentEventStatusLabelPrinted      = "label_printed"
+	FulfillmentEventStatusLabelPurchased    = "label_purchased"
+	FulfillmentEventStatusAttemptedDelivery = "attempted_delivery"
+	FulfillmentEventStatusReadyForPickup    = "ready_for_pickup"
+	FulfillmentEventStatusConfirmed         = "confirmed"
+	FulfillmentEventStatusInTransit         = "in_transit"
+	FulfillmentEventStatusOutForDelivery    = "out_for_delivery"
+	FulfillmentEventStatusDelivered         = "delivered"
+	FulfillmentEventStatusFailure           = "failure"
+)
+
+// FulfillmentEvent represents a tracking event of a fulfillment, e.g. a
+// carrier scan. Status is one of the FulfillmentEventStatus constants.
+type FulfillmentEvent struct {
+	ID                  int64      `json:"id,omitempty"`
+	FulfillmentID       int64      `json:"fulfillment_id,omitempty"`
+	OrderID             int64      `json:"order_id,omitempty"`
+	ShopID              int64      `json:"shop_id,omitempty"`
+	Status              string     `json:"status,omitempty"`
+	Message             string     `json:"message,omitempty"`
+	HappenedAt          *time.Time `json:"happened_at,omitempty"`
+	EstimatedDeliveryAt *time.Time `json:"estimated_delivery_at,omitempty"`
+	Address1            string     `json:"address1,omitempty"`
+	City                string     `json:"city,omitempty"`
+	Province            string     `json:"province,omitempty"`
+	Country             string     `json:"country,omitempty"`
+	Zip                 string     `json:"zip,omitempty"`
+	Latitude            float64    `json:"latitude,omitempty"`
+	Longitude           float64    `json:"longitude,omitempty"`
+	CreatedAt           *time.Time `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+}
+
+// fulfillmentEvents returns the resource for the events endpoints of a
+// fulfillment of an order
+func (s *FulfillmentEventServiceOp) fulfillmentEvents(orderID, fulfillmentID int64) *Resource[FulfillmentEvent] {
+	path := fmt.Sprintf("%s/%d/fulfillments/%d/events", ordersBasePath, orderID, fulfillmentID)
+	return NewResource[FulfillmentEvent](s.client, path, "fulfillment_event", "fulfillment_events")
+}
+
+// List fulfillment events
+func (s *FulfillmentEventServiceOp) List(orderID, fulfillmentID int64, options interface{}) ([]FulfillmentEvent, error) {
+	return s.fulfillmentEvents(orderID, fulfillmentID).List(options)
+}
+
+// Get individual fulfillment event
+func (s *FulfillmentEventServiceOp) Get(orderID, fulfillmentID, eventID int64, options interface{}) (*FulfillmentEvent, error) {
+	return s.fulfillmentEvents(orderID, fulfillmentID).Get(eventID, options)
+}
+
+// Create a new fulfillment event. Unlike the response, the request wraps the
+// event in an "event" root key.
+func (s *FulfillmentEventServiceOp) Create(orderID, fulfillmentID int64, event FulfillmentEvent) (*FulfillmentEvent, error) {
+	path := fmt.Sprintf("%s/%d/fulfillments/%d/events.json", ordersBasePath, orderID, fulfillmentID)
+	wrappedData := struct {
+		Event FulfillmentEvent `json:"event"`
+	}{event}
+	resource := struct {
+		FulfillmentEvent *FulfillmentEvent `json:"fulfillment_event"`
+	}{}
+	err := s.client.Post(path, wrappedData, &resource)
+	if err != nil {
+		return nil, err
+	}
+	return resource.FulfillmentEvent, nil
+}
+
+// Delete an existing fulfillment event
+func (s *FulfillmentEventServiceOp) Delete(orderID, fulfillmentID, eventID int64) error {
+	return s.fulfillmentEvents(orderID, fulfillmentID).Delete(eventID)
+}
@@ -0,0 +1,122 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	httpmock "github.com/jarcoal/httpmock"
+)
+
+func fulfillmentEventTests(t *testing.T, event FulfillmentEvent) {
+	// Check that the ID is assigned to the returned fulfillment event
+	expectedID := int64(944956395)
+	if event.ID != expectedID {
+		t.Errorf("FulfillmentEvent.ID returned %+v, expected %+v", event.ID, expectedID)
+	}
+
+	if event.Status != FulfillmentEventStatusInTransit {
+		t.Errorf("FulfillmentEvent.Status returned %+v, expected %+v", event.Status, FulfillmentEventStatusInTransit)
+	}
+
+	expectedHappenedAt := time.Date(2018, time.October, 5, 16, 59, 37, 0, time.UTC)
+	if event.HappenedAt == nil || !expectedHappenedAt.Equal(*event.HappenedAt) {
+		t.Errorf("FulfillmentEvent.HappenedAt returned %+v, expected %+v", event.HappenedAt, expectedHappenedAt)
+	}
+
+	expectedDeliveryAt := time.Date(2018, time.October, 7, 21, 0, 0, 0, time.UTC)
+	if event.EstimatedDeliveryAt == nil || !expectedDeliveryAt.Equal(*event.EstimatedDeliveryAt) {
+		t.Errorf("FulfillmentEvent.EstimatedDeliveryAt returned %+v, expected %+v", event.EstimatedDeliveryAt, expectedDeliveryAt)
+	}
+
+	expectedLatitude := 38.2526647
+	if event.Latitude != expectedLatitude {
+		t.Errorf("FulfillmentEvent.Latitude returned %+v, expected %+v", event.Latitude, expectedLatitude)
+	}
+}
+
+func TestFulfillmentEventList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders/450789469/fulfillments/255858046/events.json",
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_events.json")))
+
+	events, err := client.FulfillmentEvent.List(450789469, 255858046, nil)
+	if err != nil {
+		t.Errorf("FulfillmentEvent.List returned error: %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("FulfillmentEvent.List returned %d events, expected 2", len(events))
+	}
+	statuses := []string{events[0].Status, events[1].Status}
+	expected := []string{FulfillmentEventStatusConfirmed, FulfillmentEventStatusInTransit}
+	if !reflect.DeepEqual(statuses, expected) {
+		t.Errorf("FulfillmentEvent.List returned statuses %+v, expected %+v", statuses, expected)
+	}
+}
+
+func TestFulfillmentEventGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders/450789469/fulfillments/255858046/events/944956395.json",
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_event.json")))
+
+	event, err := client.FulfillmentEvent.Get(450789469, 255858046, 944956395, nil)
+	if err != nil {
+		t.Errorf("FulfillmentEvent.Get returned error: %v", err)
+	}
+
+	fulfillmentEventTests(t, *event)
+}
+
+func TestFulfillmentEventCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/orders/450789469/fulfillments/255858046/events.json",
+		bodyCheckingResponder(t,
+			`{"event": {
+				"status": "in_transit",
+				"message": "Arrived at sorting facility",
+				"happened_at": "2018-10-05T16:59:37Z",
+				"estimated_delivery_at": "2018-10-07T21:00:00Z",
+				"latitude": 38.2526647,
+				"longitude": -85.7584557
+			}}`,
+			201,
+			loadFixture("fulfillment_event.json")))
+
+	happenedAt := time.Date(2018, time.October, 5, 16, 59, 37, 0, time.UTC)
+	estimatedDeliveryAt := time.Date(2018, time.October, 7, 21, 0, 0, 0, time.UTC)
+	event := FulfillmentEvent{
+		Status:              FulfillmentEventStatusInTransit,
+		Message:             "Arrived at sorting facility",
+		HappenedAt:          &happenedAt,
+		EstimatedDeliveryAt: &estimatedDeliveryAt,
+		Latitude:            38.2526647,
+		Longitude:           -85.7584557,
+	}
+
+	created, err := client.FulfillmentEvent.Create(450789469, 255858046, event)
+	if err != nil {
+		t.Errorf("FulfillmentEvent.Create returned error: %v", err)
+	}
+
+	fulfillmentEventTests(t, *created)
+}
+
+func TestFulfillmentEventDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/orders/450789469/fulfillments/255858046/events/944956395.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.FulfillmentEvent.Delete(450789469, 255858046, 944956395)
+	if err != nil {
+		t.Errorf("FulfillmentEvent.Delete returned error: %v", err)
+	}
+}
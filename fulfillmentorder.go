@@ -0,0 +1,312 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const fulfillmentOrdersBasePath = "admin/fulfillment_orders"
+
+// FulfillmentOrderService is an interface for interfacing with the
+// fulfillment orders endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/shipping-and-fulfillment/fulfillmentorder
+type FulfillmentOrderService interface {
+	List(int64, interface{}) ([]FulfillmentOrder, error)
+	Get(int64, interface{}) (*FulfillmentOrder, error)
+	Cancel(int64) (*FulfillmentOrder, error)
+	Close(int64, string) (*FulfillmentOrder, error)
+	Open(int64) (*FulfillmentOrder, error)
+	Hold(int64, FulfillmentHold) (*FulfillmentOrder, error)
+	ReleaseHold(int64) (*FulfillmentOrder, error)
+	Move(int64, int64, []FulfillmentOrderLineItem) (*FulfillmentOrderMoveResult, error)
+	Reschedule(int64, time.Time) (*FulfillmentOrder, error)
+	RequestFulfillment(int64, string, []FulfillmentOrderLineItem) (*FulfillmentRequestResult, error)
+	AcceptFulfillmentRequest(int64, string) (*FulfillmentOrder, error)
+	RejectFulfillmentRequest(int64, string) (*FulfillmentOrder, error)
+	RequestCancellation(int64, string) (*FulfillmentOrder, error)
+	AcceptCancellationRequest(int64, string) (*FulfillmentOrder, error)
+	RejectCancellationRequest(int64, string) (*FulfillmentOrder, error)
+	CreateFulfillment(Fulfillment) (*Fulfillment, error)
+}
+
+// FulfillmentOrderServiceOp handles communication with the fulfillment order
+// related methods of the Shopify API.
+type FulfillmentOrderServiceOp struct {
+	client *Client
+}
+
+// Statuses of a fulfillment order
+const (
+	FulfillmentOrderStatusOpen       = "open"
+	FulfillmentOrderStatusInProgress = "in_progress"
+	FulfillmentOrderStatusScheduled  = "scheduled"
+	FulfillmentOrderStatusOnHold     = "on_hold"
+	FulfillmentOrderStatusCancelled  = "cancelled"
+	FulfillmentOrderStatusIncomplete = "incomplete"
+	FulfillmentOrderStatusClosed     = "closed"
+)
+
+// Request statuses of a fulfillment order
+const (
+	FulfillmentOrderRequestStatusUnsubmitted           = "unsubmitted"
+	FulfillmentOrderRequestStatusSubmitted             = "submitted"
+	FulfillmentOrderRequestStatusAccepted              = "accepted"
+	FulfillmentOrderRequestStatusRejected              = "rejected"
+	FulfillmentOrderRequestStatusCancellationRequested = "cancellation_requested"
+	FulfillmentOrderRequestStatusCancellationAccepted  = "cancellation_accepted"
+	FulfillmentOrderRequestStatusCancellationRejected  = "cancellation_rejected"
+	FulfillmentOrderRequestStatusClosed                = "closed"
+)
+
+// Reasons for holding a fulfillment order
+const (
+	FulfillmentHoldReasonAwaitingPayment     = "awaiting_payment"
+	FulfillmentHoldReasonHighRiskOfFraud     = "high_risk_of_fraud"
+	FulfillmentHoldReasonIncorrectAddress    = "incorrect_address"
+	FulfillmentHoldReasonInventoryOutOfStock = "inventory_out_of_stock"
+	FulfillmentHoldReasonOther               = "other"
+)
+
+// FulfillmentOrder represents a group of line items of an order that are
+// fulfilled from the same location
+type FulfillmentOrder struct {
+	ID                 int64                             `json:"id,omitempty"`
+	ShopID             int64                             `json:"shop_id,omitempty"`
+	OrderID            int64                             `json:"order_id,omitempty"`
+	AssignedLocationID int64                             `json:"assigned_location_id,omitempty"`
+	AssignedLocation   *FulfillmentOrderAssignedLocation `json:"assigned_location,omitempty"`
+	Destination        *FulfillmentOrderDestination      `json:"destination,omitempty"`
+	DeliveryMethod     *FulfillmentOrderDeliveryMethod   `json:"delivery_method,omitempty"`
+	LineItems          []FulfillmentOrderLineItem        `json:"line_items,omitempty"`
+	Status             string                            `json:"status,omitempty"`
+	RequestStatus      string                            `json:"request_status,omitempty"`
+	SupportedActions   []string                          `json:"supported_actions,omitempty"`
+	FulfillmentHolds   []FulfillmentHold                 `json:"fulfillment_holds,omitempty"`
+	MerchantRequests   []FulfillmentOrderMerchantRequest `json:"merchant_requests,omitempty"`
+	FulfillAt          *time.Time                        `json:"fulfill_at,omitempty"`
+	FulfillBy          *time.Time                        `json:"fulfill_by,omitempty"`
+	CreatedAt          *time.Time                        `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time                        `json:"updated_at,omitempty"`
+}
+
+// FulfillmentOrderLineItem is a line item of a fulfillment order. Only ID and
+// Quantity are used when passing line items to the service.
+type FulfillmentOrderLineItem struct {
+	ID                  int64 `json:"id,omitempty"`
+	ShopID              int64 `json:"shop_id,omitempty"`
+	FulfillmentOrderID  int64 `json:"fulfillment_order_id,omitempty"`
+	LineItemID          int64 `json:"line_item_id,omitempty"`
+	InventoryItemID     int64 `json:"inventory_item_id,omitempty"`
+	VariantID           int64 `json:"variant_id,omitempty"`
+	Quantity            int   `json:"quantity,omitempty"`
+	FulfillableQuantity int   `json:"fulfillable_quantity,omitempty"`
+}
+
+// FulfillmentOrderAssignedLocation is the location a fulfillment order is
+// fulfilled from
+type FulfillmentOrderAssignedLocation struct {
+	LocationID  int64  `json:"location_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Address1    string `json:"address1,omitempty"`
+	Address2    string `json:"address2,omitempty"`
+	City        string `json:"city,omitempty"`
+	Zip         string `json:"zip,omitempty"`
+	Province    string `json:"province,omitempty"`
+	CountryCode string `json:"country_code,omitempty"`
+	Phone       string `json:"phone,omitempty"`
+}
+
+// FulfillmentOrderDestination is the address a fulfillment order is shipped
+// to
+type FulfillmentOrderDestination struct {
+	ID        int64  `json:"id,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Company   string `json:"company,omitempty"`
+	Address1  string `json:"address1,omitempty"`
+	Address2  string `json:"address2,omitempty"`
+	City      string `json:"city,omitempty"`
+	Zip       string `json:"zip,omitempty"`
+	Province  string `json:"province,omitempty"`
+	Country   string `json:"country,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+}
+
+// FulfillmentOrderDeliveryMethod is the delivery method of a fulfillment
+// order, e.g. "shipping" or "local"
+type FulfillmentOrderDeliveryMethod struct {
+	ID         int64  `json:"id,omitempty"`
+	MethodType string `json:"method_type,omitempty"`
+}
+
+// FulfillmentHold is a hold on a fulfillment order. Reason is one of the
+// FulfillmentHoldReason constants.
+type FulfillmentHold struct {
+	Reason         string `json:"reason,omitempty"`
+	ReasonNotes    string `json:"reason_notes,omitempty"`
+	NotifyMerchant bool   `json:"notify_merchant,omitempty"`
+}
+
+// FulfillmentOrderMerchantRequest is a request the merchant sent to the
+// fulfillment service of a fulfillment order, e.g. a fulfillment request or a
+// cancellation request
+type FulfillmentOrderMerchantRequest struct {
+	Message        string                 `json:"message,omitempty"`
+	Kind           string                 `json:"kind,omitempty"`
+	RequestOptions map[string]interface{} `json:"request_options,omitempty"`
+}
+
+// FulfillmentOrderMoveResult is the result of moving a fulfillment order to
+// another location
+type FulfillmentOrderMoveResult struct {
+	OriginalFulfillmentOrder  *FulfillmentOrder `json:"original_fulfillment_order"`
+	MovedFulfillmentOrder     *FulfillmentOrder `json:"moved_fulfillment_order"`
+	RemainingFulfillmentOrder *FulfillmentOrder `json:"remaining_fulfillment_order"`
+}
+
+// FulfillmentRequestResult is the result of sending a fulfillment request
+// for a fulfillment order
+type FulfillmentRequestResult struct {
+	OriginalFulfillmentOrder    *FulfillmentOrder `json:"original_fulfillment_order"`
+	SubmittedFulfillmentOrder   *FulfillmentOrder `json:"submitted_fulfillment_order"`
+	UnsubmittedFulfillmentOrder *FulfillmentOrder `json:"unsubmitted_fulfillment_order"`
+}
+
+// LineItemsByFulfillmentOrder selects the line items of a fulfillment order
+// to fulfill. All line items are fulfilled if FulfillmentOrderLineItems is
+// empty.
+type LineItemsByFulfillmentOrder struct {
+	FulfillmentOrderID        int64                      `json:"fulfillment_order_id"`
+	FulfillmentOrderLineItems []FulfillmentOrderLineItem `json:"fulfillment_order_line_items,omitempty"`
+}
+
+// fulfillmentOrders returns the resource for the fulfillment orders endpoints
+func (s *FulfillmentOrderServiceOp) fulfillmentOrders() *Resource[FulfillmentOrder] {
+	return NewResource[FulfillmentOrder](s.client, fulfillmentOrdersBasePath, "fulfillment_order", "fulfillment_orders")
+}
+
+// requestMessage wraps a message in the given root key, e.g. for accepting a
+// fulfillment request
+func requestMessage(key, message string) map[string]interface{} {
+	return map[string]interface{}{key: map[string]string{"message": message}}
+}
+
+// List the fulfillment orders of an order
+func (s *FulfillmentOrderServiceOp) List(orderID int64, options interface{}) ([]FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/fulfillment_orders", ordersBasePath, orderID)
+	return NewResource[FulfillmentOrder](s.client, path, "fulfillment_order", "fulfillment_orders").List(options)
+}
+
+// Get individual fulfillment order
+func (s *FulfillmentOrderServiceOp) Get(fulfillmentOrderID int64, options interface{}) (*FulfillmentOrder, error) {
+	return s.fulfillmentOrders().Get(fulfillmentOrderID, options)
+}
+
+// Cancel a fulfillment order
+func (s *FulfillmentOrderServiceOp) Cancel(fulfillmentOrderID int64) (*FulfillmentOrder, error) {
+	return s.fulfillmentOrders().Action(fulfillmentOrderID, "cancel", nil)
+}
+
+// Close a fulfillment order as incomplete, with an optional message
+func (s *FulfillmentOrderServiceOp) Close(fulfillmentOrderID int64, message string) (*FulfillmentOrder, error) {
+	return s.fulfillmentOrders().Action(fulfillmentOrderID, "close", requestMessage("fulfillment_order", message))
+}
+
+// Open a scheduled fulfillment order, which makes it ready for fulfillment
+func (s *FulfillmentOrderServiceOp) Open(fulfillmentOrderID int64) (*FulfillmentOrder, error) {
+	return s.fulfillmentOrders().Action(fulfillmentOrderID, "open", nil)
+}
+
+// Hold a fulfillment order
+func (s *FulfillmentOrderServiceOp) Hold(fulfillmentOrderID int64, hold FulfillmentHold) (*FulfillmentOrder, error) {
+	wrappedData := map[string]FulfillmentHold{"fulfillment_hold": hold}
+	return s.fulfillmentOrders().Action(fulfillmentOrderID, "hold", wrappedData)
+}
+
+// ReleaseHold releases the hold on a fulfillment order
+func (s *FulfillmentOrderServiceOp) ReleaseHold(fulfillmentOrderID int64) (*FulfillmentOrder, error) {
+	return s.fulfillmentOrders().Action(fulfillmentOrderID, "release_hold", nil)
+}
+
+// Move a fulfillment order to another location. Only the given line items
+// are moved, or all line items if none are given.
+func (s *FulfillmentOrderServiceOp) Move(fulfillmentOrderID, newLocationID int64, lineItems []FulfillmentOrderLineItem) (*FulfillmentOrderMoveResult, error) {
+	path := fmt.Sprintf("%s/%d/move.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	data := map[string]interface{}{
+		"fulfillment_order": struct {
+			NewLocationID int64                      `json:"new_location_id"`
+			LineItems     []FulfillmentOrderLineItem `json:"fulfillment_order_line_items,omitempty"`
+		}{newLocationID, lineItems},
+	}
+	result := new(FulfillmentOrderMoveResult)
+	err := s.client.Post(path, data, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Reschedule the fulfill at time of a scheduled fulfillment order
+func (s *FulfillmentOrderServiceOp) Reschedule(fulfillmentOrderID int64, fulfillAt time.Time) (*FulfillmentOrder, error) {
+	data := map[string]interface{}{
+		"fulfillment_order": map[string]time.Time{"new_fulfill_at": fulfillAt},
+	}
+	return s.fulfillmentOrders().Action(fulfillmentOrderID, "reschedule", data)
+}
+
+// RequestFulfillment sends a fulfillment request to the fulfillment service
+// of a fulfillment order. Only the given line items are requested, or all
+// line items if none are given.
+func (s *FulfillmentOrderServiceOp) RequestFulfillment(fulfillmentOrderID int64, message string, lineItems []FulfillmentOrderLineItem) (*FulfillmentRequestResult, error) {
+	path := fmt.Sprintf("%s/%d/fulfillment_request.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	data := map[string]interface{}{
+		"fulfillment_request": struct {
+			Message   string                     `json:"message,omitempty"`
+			LineItems []FulfillmentOrderLineItem `json:"fulfillment_order_line_items,omitempty"`
+		}{message, lineItems},
+	}
+	result := new(FulfillmentRequestResult)
+	err := s.client.Post(path, data, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AcceptFulfillmentRequest accepts the fulfillment request of a fulfillment
+// order. Used by fulfillment services.
+func (s *FulfillmentOrderServiceOp) AcceptFulfillmentRequest(fulfillmentOrderID int64, message string) (*FulfillmentOrder, error) {
+	return s.fulfillmentOrders().Action(fulfillmentOrderID, "fulfillment_request/accept", requestMessage("fulfillment_request", message))
+}
+
+// RejectFulfillmentRequest rejects the fulfillment request of a fulfillment
+// order. Used by fulfillment services.
+func (s *FulfillmentOrderServiceOp) RejectFulfillmentRequest(fulfillmentOrderID int64, message string) (*FulfillmentOrder, error) {
+	return s.fulfillmentOrders().Action(fulfillmentOrderID, "fulfillment_request/reject", requestMessage("fulfillment_request", message))
+}
+
+// RequestCancellation sends a cancellation request to the fulfillment
+// service of a fulfillment order
+func (s *FulfillmentOrderServiceOp) RequestCancellation(fulfillmentOrderID int64, message string) (*FulfillmentOrder, error) {
+	return s.fulfillmentOrders().Action(fulfillmentOrderID, "cancellation_request", requestMessage("cancellation_request", message))
+}
+
+// AcceptCancellationRequest accepts the cancellation request of a
+// fulfillment order. Used by fulfillment services.
+func (s *FulfillmentOrderServiceOp) AcceptCancellationRequest(fulfillmentOrderID int64, message string) (*FulfillmentOrder, error) {
+	return s.fulfillmentOrders().Action(fulfillmentOrderID, "cancellation_request/accept", requestMessage("cancellation_request", message))
+}
+
+// RejectCancellationRequest rejects the cancellation request of a
+// fulfillment order. Used by fulfillment services.
+func (s *FulfillmentOrderServiceOp) RejectCancellationRequest(fulfillmentOrderID int64, message string) (*FulfillmentOrder, error) {
+	return s.fulfillmentOrders().Action(fulfillmentOrderID, "cancellation_request/reject", requestMessage("cancellation_request", message))
+}
+
+// CreateFulfillment creates a fulfillment for the line items of one or more
+// fulfillment orders, set in LineItemsByFulfillmentOrder
+func (s *FulfillmentOrderServiceOp) CreateFulfillment(fulfillment Fulfillment) (*Fulfillment, error) {
+	fulfillmentService := &FulfillmentServiceOp{client: s.client}
+	return fulfillmentService.Create(fulfillment)
+}
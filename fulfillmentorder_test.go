@@ -0,0 +1,305 @@
+package goshopify
+
+import (
+	"testing"
+	"time"
+
+	httpmock "github.com/jarcoal/httpmock"
+)
+
+func fulfillmentOrderTests(t *testing.T, fulfillmentOrder FulfillmentOrder) {
+	// Check that the ID is assigned to the returned fulfillment order
+	expectedID := int64(1046000778)
+	if fulfillmentOrder.ID != expectedID {
+		t.Errorf("FulfillmentOrder.ID returned %+v, expected %+v", fulfillmentOrder.ID, expectedID)
+	}
+
+	expectedLocationID := int64(24826418)
+	if fulfillmentOrder.AssignedLocationID != expectedLocationID {
+		t.Errorf("FulfillmentOrder.AssignedLocationID returned %+v, expected %+v", fulfillmentOrder.AssignedLocationID, expectedLocationID)
+	}
+
+	if fulfillmentOrder.Status != FulfillmentOrderStatusOpen {
+		t.Errorf("FulfillmentOrder.Status returned %+v, expected %+v", fulfillmentOrder.Status, FulfillmentOrderStatusOpen)
+	}
+
+	if len(fulfillmentOrder.LineItems) != 2 {
+		t.Fatalf("FulfillmentOrder.LineItems returned %d items, expected 2", len(fulfillmentOrder.LineItems))
+	}
+	expectedLineItemID := int64(518995019)
+	if fulfillmentOrder.LineItems[1].LineItemID != expectedLineItemID {
+		t.Errorf("FulfillmentOrderLineItem.LineItemID returned %+v, expected %+v", fulfillmentOrder.LineItems[1].LineItemID, expectedLineItemID)
+	}
+
+	if fulfillmentOrder.AssignedLocation == nil || fulfillmentOrder.AssignedLocation.CountryCode != "DE" {
+		t.Errorf("FulfillmentOrder.AssignedLocation returned %+v, expected country DE", fulfillmentOrder.AssignedLocation)
+	}
+
+	d := time.Date(2021, time.January, 1, 5, 0, 0, 0, time.UTC)
+	if fulfillmentOrder.FulfillAt == nil || !d.Equal(*fulfillmentOrder.FulfillAt) {
+		t.Errorf("FulfillmentOrder.FulfillAt returned %+v, expected %+v", fulfillmentOrder.FulfillAt, d)
+	}
+}
+
+func TestFulfillmentOrderList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders/450789469/fulfillment_orders.json",
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_orders.json")))
+
+	fulfillmentOrders, err := client.FulfillmentOrder.List(450789469, nil)
+	if err != nil {
+		t.Errorf("FulfillmentOrder.List returned error: %v", err)
+	}
+
+	if len(fulfillmentOrders) != 2 {
+		t.Fatalf("FulfillmentOrder.List returned %d fulfillment orders, expected 2", len(fulfillmentOrders))
+	}
+
+	requests := fulfillmentOrders[1].MerchantRequests
+	if len(requests) != 1 || requests[0].Kind != "fulfillment_request" || requests[0].Message != "Fragile" {
+		t.Errorf("FulfillmentOrder.MerchantRequests returned %+v, expected a fulfillment request", requests)
+	}
+}
+
+func TestFulfillmentOrderGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/fulfillment_orders/1046000778.json",
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_order.json")))
+
+	fulfillmentOrder, err := client.FulfillmentOrder.Get(1046000778, nil)
+	if err != nil {
+		t.Errorf("FulfillmentOrder.Get returned error: %v", err)
+	}
+
+	fulfillmentOrderTests(t, *fulfillmentOrder)
+}
+
+func TestFulfillmentOrderActions(t *testing.T) {
+	cases := []struct {
+		action string
+		body   string
+		call   func() (*FulfillmentOrder, error)
+	}{
+		{
+			"cancel",
+			`null`,
+			func() (*FulfillmentOrder, error) { return client.FulfillmentOrder.Cancel(1046000778) },
+		},
+		{
+			"close",
+			`{"fulfillment_order": {"message": "Not enough stock"}}`,
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentOrder.Close(1046000778, "Not enough stock")
+			},
+		},
+		{
+			"open",
+			`null`,
+			func() (*FulfillmentOrder, error) { return client.FulfillmentOrder.Open(1046000778) },
+		},
+		{
+			"hold",
+			`{"fulfillment_hold": {"reason": "inventory_out_of_stock", "reason_notes": "Restock next week", "notify_merchant": true}}`,
+			func() (*FulfillmentOrder, error) {
+				hold := FulfillmentHold{
+					Reason:         FulfillmentHoldReasonInventoryOutOfStock,
+					ReasonNotes:    "Restock next week",
+					NotifyMerchant: true,
+				}
+				return client.FulfillmentOrder.Hold(1046000778, hold)
+			},
+		},
+		{
+			"release_hold",
+			`null`,
+			func() (*FulfillmentOrder, error) { return client.FulfillmentOrder.ReleaseHold(1046000778) },
+		},
+		{
+			"reschedule",
+			`{"fulfillment_order": {"new_fulfill_at": "2021-01-01T05:00:00Z"}}`,
+			func() (*FulfillmentOrder, error) {
+				fulfillAt := time.Date(2021, time.January, 1, 5, 0, 0, 0, time.UTC)
+				return client.FulfillmentOrder.Reschedule(1046000778, fulfillAt)
+			},
+		},
+		{
+			"fulfillment_request/accept",
+			`{"fulfillment_request": {"message": "We will start processing your fulfillment"}}`,
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentOrder.AcceptFulfillmentRequest(1046000778, "We will start processing your fulfillment")
+			},
+		},
+		{
+			"fulfillment_request/reject",
+			`{"fulfillment_request": {"message": "Not enough inventory"}}`,
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentOrder.RejectFulfillmentRequest(1046000778, "Not enough inventory")
+			},
+		},
+		{
+			"cancellation_request",
+			`{"cancellation_request": {"message": "The customer changed their mind"}}`,
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentOrder.RequestCancellation(1046000778, "The customer changed their mind")
+			},
+		},
+		{
+			"cancellation_request/accept",
+			`{"cancellation_request": {"message": "Your order was cancelled"}}`,
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentOrder.AcceptCancellationRequest(1046000778, "Your order was cancelled")
+			},
+		},
+		{
+			"cancellation_request/reject",
+			`{"cancellation_request": {"message": "Already shipped"}}`,
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentOrder.RejectCancellationRequest(1046000778, "Already shipped")
+			},
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+
+		httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/fulfillment_orders/1046000778/"+c.action+".json",
+			bodyCheckingResponder(t, c.body, 200, loadFixture("fulfillment_order.json")))
+
+		fulfillmentOrder, err := c.call()
+		if err != nil {
+			t.Errorf("FulfillmentOrder %s returned error: %v", c.action, err)
+		} else {
+			fulfillmentOrderTests(t, *fulfillmentOrder)
+		}
+
+		teardown()
+	}
+}
+
+func TestFulfillmentOrderMove(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/fulfillment_orders/1046000778/move.json",
+		bodyCheckingResponder(t,
+			`{"fulfillment_order": {"new_location_id": 655441491, "fulfillment_order_line_items": [{"id": 1058737483, "quantity": 1}]}}`,
+			200,
+			[]byte(`{
+				"original_fulfillment_order": {"id": 1046000778, "status": "closed"},
+				"moved_fulfillment_order": {"id": 1046000780, "assigned_location_id": 655441491, "status": "open"},
+				"remaining_fulfillment_order": null
+			}`)))
+
+	lineItems := []FulfillmentOrderLineItem{{ID: 1058737483, Quantity: 1}}
+	result, err := client.FulfillmentOrder.Move(1046000778, 655441491, lineItems)
+	if err != nil {
+		t.Fatalf("FulfillmentOrder.Move returned error: %v", err)
+	}
+
+	if result.OriginalFulfillmentOrder == nil || result.OriginalFulfillmentOrder.Status != FulfillmentOrderStatusClosed {
+		t.Errorf("FulfillmentOrderMoveResult.OriginalFulfillmentOrder returned %+v, expected a closed fulfillment order", result.OriginalFulfillmentOrder)
+	}
+	if result.MovedFulfillmentOrder == nil || result.MovedFulfillmentOrder.AssignedLocationID != 655441491 {
+		t.Errorf("FulfillmentOrderMoveResult.MovedFulfillmentOrder returned %+v, expected location %d", result.MovedFulfillmentOrder, 655441491)
+	}
+	if result.RemainingFulfillmentOrder != nil {
+		t.Errorf("FulfillmentOrderMoveResult.RemainingFulfillmentOrder returned %+v, expected nil", result.RemainingFulfillmentOrder)
+	}
+}
+
+func TestFulfillmentOrderRequestFulfillment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/fulfillment_orders/1046000778/fulfillment_request.json",
+		bodyCheckingResponder(t,
+			`{"fulfillment_request": {"message": "Fulfill this ASAP please."}}`,
+			200,
+			[]byte(`{
+				"original_fulfillment_order": {"id": 1046000778, "request_status": "submitted"},
+				"submitted_fulfillment_order": {"id": 1046000778, "request_status": "submitted"},
+				"unsubmitted_fulfillment_order": null
+			}`)))
+
+	result, err := client.FulfillmentOrder.RequestFulfillment(1046000778, "Fulfill this ASAP please.", nil)
+	if err != nil {
+		t.Fatalf("FulfillmentOrder.RequestFulfillment returned error: %v", err)
+	}
+
+	if result.SubmittedFulfillmentOrder == nil || result.SubmittedFulfillmentOrder.RequestStatus != FulfillmentOrderRequestStatusSubmitted {
+		t.Errorf("FulfillmentRequestResult.SubmittedFulfillmentOrder returned %+v, expected a submitted fulfillment order", result.SubmittedFulfillmentOrder)
+	}
+	if result.UnsubmittedFulfillmentOrder != nil {
+		t.Errorf("FulfillmentRequestResult.UnsubmittedFulfillmentOrder returned %+v, expected nil", result.UnsubmittedFulfillmentOrder)
+	}
+}
+
+func TestFulfillmentOrderMoveAndRequestErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/fulfillment_orders/1046000778/move.json",
+		httpmock.NewStringResponder(422, `{"errors": ["Fulfillment order is closed"]}`))
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/fulfillment_orders/1046000778/fulfillment_request.json",
+		httpmock.NewStringResponder(422, `{"errors": ["Fulfillment order is closed"]}`))
+
+	moved, err := client.FulfillmentOrder.Move(1046000778, 655441491, nil)
+	if err == nil {
+		t.Errorf("FulfillmentOrder.Move expected error, got nil")
+	}
+	if moved != nil {
+		t.Errorf("FulfillmentOrder.Move returned %+v, expected nil", moved)
+	}
+
+	requested, err := client.FulfillmentOrder.RequestFulfillment(1046000778, "", nil)
+	if err == nil {
+		t.Errorf("FulfillmentOrder.RequestFulfillment expected error, got nil")
+	}
+	if requested != nil {
+		t.Errorf("FulfillmentOrder.RequestFulfillment returned %+v, expected nil", requested)
+	}
+}
+
+func TestFulfillmentOrderCreateFulfillment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/fulfillments.json",
+		bodyCheckingResponder(t,
+			`{"fulfillment": {
+				"notify_customer": true,
+				"receipt": {},
+				"line_items_by_fulfillment_order": [
+					{"fulfillment_order_id": 1046000778, "fulfillment_order_line_items": [{"id": 1058737482, "quantity": 1}]}
+				],
+				"tracking_info": {"number": "MS1562678", "url": "https://www.my-shipping-company.com?tracking_number=MS1562678", "company": "My Shipping Company"}
+			}}`,
+			201,
+			loadFixture("fulfillment.json")))
+
+	fulfillment := Fulfillment{
+		NotifyCustomer: true,
+		LineItemsByFulfillmentOrder: []LineItemsByFulfillmentOrder{
+			{
+				FulfillmentOrderID:        1046000778,
+				FulfillmentOrderLineItems: []FulfillmentOrderLineItem{{ID: 1058737482, Quantity: 1}},
+			},
+		},
+		TrackingInfo: &FulfillmentTrackingInfo{
+			Number:  "MS1562678",
+			URL:     "https://www.my-shipping-company.com?tracking_number=MS1562678",
+			Company: "My Shipping Company",
+		},
+	}
+
+	created, err := client.FulfillmentOrder.CreateFulfillment(fulfillment)
+	if err != nil {
+		t.Errorf("FulfillmentOrder.CreateFulfillment returned error: %v", err)
+	}
+
+	FulfillmentTests(t, *created)
+}
@@ -0,0 +1,210 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+const fulfillmentServicesBasePath = "admin/fulfillment_services"
+
+// Scopes of the fulfillment services to list
+const (
+	FulfillmentServiceScopeAll           = "all"
+	FulfillmentServiceScopeCurrentClient = "current_client"
+)
+
+// FulfillmentServiceService is an interface for interfacing with the
+// fulfillment services endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/shipping-and-fulfillment/fulfillmentservice
+type FulfillmentServiceService interface {
+	List(interface{}) ([]FulfillmentServiceData, error)
+	Get(int64, interface{}) (*FulfillmentServiceData, error)
+	Create(FulfillmentServiceData) (*FulfillmentServiceData, error)
+	Update(FulfillmentServiceData) (*FulfillmentServiceData, error)
+	Delete(int64) error
+}
+
+// FulfillmentServiceServiceOp handles communication with the fulfillment
+// service related methods of the Shopify API.
+type FulfillmentServiceServiceOp struct {
+	client *Client
+}
+
+// FulfillmentServiceData represents a Shopify fulfillment service, a third
+// party warehouse that fulfills orders on behalf of the shop. Shopify calls
+// the CallbackURL to fetch the stock and tracking numbers of the service.
+type FulfillmentServiceData struct {
+	ID                     int64  `json:"id,omitempty"`
+	Name                   string `json:"name,omitempty"`
+	Handle                 string `json:"handle,omitempty"`
+	Email                  string `json:"email,omitempty"`
+	ServiceName            string `json:"service_name,omitempty"`
+	CallbackURL            string `json:"callback_url,omitempty"`
+	Format                 string `json:"format,omitempty"`
+	InventoryManagement    bool   `json:"inventory_management,omitempty"`
+	TrackingSupport        bool   `json:"tracking_support,omitempty"`
+	RequiresShippingMethod bool   `json:"requires_shipping_method,omitempty"`
+	FulfillmentOrdersOptIn bool   `json:"fulfillment_orders_opt_in,omitempty"`
+	PermitsSkuSharing      bool   `json:"permits_sku_sharing,omitempty"`
+	IncludePendingStock    bool   `json:"include_pending_stock,omitempty"`
+	ProviderID             int64  `json:"provider_id,omitempty"`
+	LocationID             int64  `json:"location_id,omitempty"`
+	AdminGraphqlAPIID      string `json:"admin_graphql_api_id,omitempty"`
+}
+
+// FulfillmentServiceListOptions filters the fulfillment services to list
+type FulfillmentServiceListOptions struct {
+	Scope string `url:"scope,omitempty"`
+}
+
+// FetchStockRequest is the fetch_stock request Shopify sends to the callback
+// URL of a fulfillment service. SKU is empty when Shopify asks for the stock
+// of all the SKUs of the service.
+type FetchStockRequest struct {
+	Shop       string
+	SKU        string
+	MaxRetries int
+}
+
+// FetchStockFunc returns the stock levels for a fetch_stock request, keyed
+// by SKU
+type FetchStockFunc func(FetchStockRequest) (map[string]int, error)
+
+// FetchTrackingNumbersRequest is the fetch_tracking_numbers request Shopify
+// sends to the callback URL of a fulfillment service. OrderNames are the
+// names of the fulfillments to return the tracking numbers for, e.g. #1001.1
+type FetchTrackingNumbersRequest struct {
+	Shop       string
+	OrderNames []string
+}
+
+// FetchTrackingNumbersResponse is the response to a fetch_tracking_numbers
+// request. TrackingNumbers are keyed by the order names of the request.
+type FetchTrackingNumbersResponse struct {
+	TrackingNumbers map[string]string `json:"tracking_numbers"`
+	Message         string            `json:"message"`
+	Success         bool              `json:"success"`
+}
+
+// FetchTrackingNumbersFunc returns the tracking numbers for a
+// fetch_tracking_numbers request
+type FetchTrackingNumbersFunc func(FetchTrackingNumbersRequest) (*FetchTrackingNumbersResponse, error)
+
+// verifyCallbackRequest verifies that a fulfillment service callback was sent
+// by Shopify, either by the HMAC header or by the hmac query parameter
+func (app App) verifyCallbackRequest(r *http.Request) bool {
+	if r.Header.Get(shopifyChecksumHeader) != "" {
+		return app.VerifyWebhookRequest(r)
+	}
+	ok, err := app.VerifyAuthorizationURL(r.URL)
+	return ok && err == nil
+}
+
+// FetchStockHandler returns an http.Handler for the fetch_stock callback of a
+// fulfillment service, i.e. {callback_url}/fetch_stock. It verifies that the
+// request was sent by Shopify and responds with the stock levels returned by
+// fn. Errors of fn result in a 500 response.
+func (app App) FetchStockHandler(fn FetchStockFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !app.verifyCallbackRequest(r) {
+			http.Error(w, "Invalid Signature", http.StatusUnauthorized)
+			return
+		}
+
+		q := r.URL.Query()
+		request := FetchStockRequest{
+			Shop: q.Get("shop"),
+			SKU:  q.Get("sku"),
+		}
+		if retries := q.Get("max_retries"); retries != "" {
+			n, err := strconv.Atoi(retries)
+			if err != nil {
+				http.Error(w, "Invalid max_retries", http.StatusBadRequest)
+				return
+			}
+			request.MaxRetries = n
+		}
+
+		stock, err := fn(request)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if stock == nil {
+			stock = map[string]int{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(stock)
+	})
+}
+
+// FetchTrackingNumbersHandler returns an http.Handler for the
+// fetch_tracking_numbers callback of a fulfillment service, i.e.
+// {callback_url}/fetch_tracking_numbers. It verifies that the request was
+// sent by Shopify and responds with the tracking numbers returned by fn.
+// Errors of fn result in a 500 response.
+func (app App) FetchTrackingNumbersHandler(fn FetchTrackingNumbersFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !app.verifyCallbackRequest(r) {
+			http.Error(w, "Invalid Signature", http.StatusUnauthorized)
+			return
+		}
+
+		q := r.URL.Query()
+		request := FetchTrackingNumbersRequest{
+			Shop:       q.Get("shop"),
+			OrderNames: q["order_names[]"],
+		}
+		if len(request.OrderNames) == 0 {
+			request.OrderNames = q["order_names"]
+		}
+
+		response, err := fn(request)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if response == nil {
+			response = &FetchTrackingNumbersResponse{}
+		}
+		if response.TrackingNumbers == nil {
+			response.TrackingNumbers = map[string]string{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	})
+}
+
+// fulfillmentServices returns the resource for the fulfillment services
+// endpoints
+func (s *FulfillmentServiceServiceOp) fulfillmentServices() *Resource[FulfillmentServiceData] {
+	return NewResource[FulfillmentServiceData](s.client, fulfillmentServicesBasePath, "fulfillment_service", "fulfillment_services")
+}
+
+// List fulfillment services
+func (s *FulfillmentServiceServiceOp) List(options interface{}) ([]FulfillmentServiceData, error) {
+	return s.fulfillmentServices().List(options)
+}
+
+// Get individual fulfillment service
+func (s *FulfillmentServiceServiceOp) Get(fulfillmentServiceID int64, options interface{}) (*FulfillmentServiceData, error) {
+	return s.fulfillmentServices().Get(fulfillmentServiceID, options)
+}
+
+// Create a new fulfillment service
+func (s *FulfillmentServiceServiceOp) Create(fulfillmentService FulfillmentServiceData) (*FulfillmentServiceData, error) {
+	return s.fulfillmentServices().Create(fulfillmentService)
+}
+
+// Update an existing fulfillment service
+func (s *FulfillmentServiceServiceOp) Update(fulfillmentService FulfillmentServiceData) (*FulfillmentServiceData, error) {
+	return s.fulfillmentServices().Update(fulfillmentService.ID, fulfillmentService)
+}
+
+// Delete an existing fulfillment service
+func (s *FulfillmentServiceServiceOp) Delete(fulfillmentServiceID int64) error {
+	return s.fulfillmentServices().Delete(fulfillmentServiceID)
+}
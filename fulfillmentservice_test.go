@@ -0,0 +1,303 @@
+package goshopify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+)
+
+func fulfillmentServiceTests(t *testing.T, fulfillmentService FulfillmentServiceData) {
+	// Check that the ID is assigned to the returned fulfillment service
+	expectedID := int64(611870435)
+	if fulfillmentService.ID != expectedID {
+		t.Errorf("FulfillmentService.ID returned %+v, expected %+v", fulfillmentService.ID, expectedID)
+	}
+
+	expectedLocationID := int64(1072404542)
+	if fulfillmentService.LocationID != expectedLocationID {
+		t.Errorf("FulfillmentService.LocationID returned %+v, expected %+v", fulfillmentService.LocationID, expectedLocationID)
+	}
+
+	if !fulfillmentService.InventoryManagement || !fulfillmentService.TrackingSupport {
+		t.Errorf("FulfillmentService returned %+v, expected inventory management and tracking support", fulfillmentService)
+	}
+
+	id, err := GIDID(GIDFulfillmentService, fulfillmentService.AdminGraphqlAPIID)
+	if err != nil || id != expectedID {
+		t.Errorf("FulfillmentService.AdminGraphqlAPIID returned %+v, expected the GID of %+v", fulfillmentService.AdminGraphqlAPIID, expectedID)
+	}
+}
+
+// signedCallbackURL returns the URL of a fulfillment service callback with
+// the given query, signed with the secret of the test app the same way
+// Shopify signs the query of its callbacks
+func signedCallbackURL(path string, query url.Values) string {
+	message, _ := url.QueryUnescape(query.Encode())
+	signed := url.Values{}
+	for k, v := range query {
+		signed[k] = v
+	}
+	mac := hmac.New(sha256.New, []byte(app.ApiSecret))
+	mac.Write([]byte(message))
+	signed.Set("hmac", hex.EncodeToString(mac.Sum(nil)))
+	return path + "?" + signed.Encode()
+}
+
+func TestFulfillmentServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/fulfillment_services.json?scope=all",
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_services.json")))
+
+	fulfillmentServices, err := client.FulfillmentService.List(FulfillmentServiceListOptions{Scope: FulfillmentServiceScopeAll})
+	if err != nil {
+		t.Errorf("FulfillmentService.List returned error: %v", err)
+	}
+
+	if len(fulfillmentServices) != 2 {
+		t.Fatalf("FulfillmentService.List returned %d fulfillment services, expected 2", len(fulfillmentServices))
+	}
+	fulfillmentServiceTests(t, fulfillmentServices[0])
+}
+
+func TestFulfillmentServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/fulfillment_services/611870435.json",
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_service.json")))
+
+	fulfillmentService, err := client.FulfillmentService.Get(611870435, nil)
+	if err != nil {
+		t.Errorf("FulfillmentService.Get returned error: %v", err)
+	}
+
+	fulfillmentServiceTests(t, *fulfillmentService)
+}
+
+func TestFulfillmentServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/fulfillment_services.json",
+		bodyCheckingResponder(t,
+			`{"fulfillment_service": {
+				"name": "Venus Fulfillment",
+				"callback_url": "http://google.com/",
+				"format": "json",
+				"inventory_management": true,
+				"tracking_support": true,
+				"requires_shipping_method": true,
+				"fulfillment_orders_opt_in": true
+			}}`,
+			201,
+			loadFixture("fulfillment_service.json")))
+
+	fulfillmentService := FulfillmentServiceData{
+		Name:                   "Venus Fulfillment",
+		CallbackURL:            "http://google.com/",
+		Format:                 "json",
+		InventoryManagement:    true,
+		TrackingSupport:        true,
+		RequiresShippingMethod: true,
+		FulfillmentOrdersOptIn: true,
+	}
+
+	created, err := client.FulfillmentService.Create(fulfillmentService)
+	if err != nil {
+		t.Errorf("FulfillmentService.Create returned error: %v", err)
+	}
+
+	fulfillmentServiceTests(t, *created)
+}
+
+func TestFulfillmentServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/fulfillment_services/611870435.json",
+		bodyCheckingResponder(t,
+			`{"fulfillment_service": {"id": 611870435, "name": "New Fulfillment Service Name"}}`,
+			200,
+			[]byte(`{"fulfillment_service": {"id": 611870435, "name": "New Fulfillment Service Name"}}`)))
+
+	fulfillmentService := FulfillmentServiceData{
+		ID:   611870435,
+		Name: "New Fulfillment Service Name",
+	}
+
+	updated, err := client.FulfillmentService.Update(fulfillmentService)
+	if err != nil {
+		t.Errorf("FulfillmentService.Update returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(updated, &fulfillmentService) {
+		t.Errorf("FulfillmentService.Update returned %+v, expected %+v", updated, fulfillmentService)
+	}
+}
+
+func TestFulfillmentServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/fulfillment_services/611870435.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.FulfillmentService.Delete(611870435)
+	if err != nil {
+		t.Errorf("FulfillmentService.Delete returned error: %v", err)
+	}
+}
+
+func TestFetchStockHandler(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var received FetchStockRequest
+	handler := app.FetchStockHandler(func(req FetchStockRequest) (map[string]int, error) {
+		received = req
+		return map[string]int{req.SKU: 23}, nil
+	})
+
+	query := url.Values{
+		"shop":        {"fooshop.myshopify.com"},
+		"sku":         {"IPOD2008GREEN"},
+		"max_retries": {"3"},
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", signedCallbackURL("/fetch_stock.json", query), nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("FetchStockHandler returned status %d, expected %d", w.Code, http.StatusOK)
+	}
+
+	expected := `{"IPOD2008GREEN":23}` + "\n"
+	if w.Body.String() != expected {
+		t.Errorf("FetchStockHandler returned %s, expected %s", w.Body.String(), expected)
+	}
+
+	expectedRequest := FetchStockRequest{Shop: "fooshop.myshopify.com", SKU: "IPOD2008GREEN", MaxRetries: 3}
+	if received != expectedRequest {
+		t.Errorf("FetchStockHandler received %+v, expected %+v", received, expectedRequest)
+	}
+}
+
+func TestFetchStockHandlerWebhookSignature(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := app.FetchStockHandler(func(req FetchStockRequest) (map[string]int, error) {
+		return nil, nil
+	})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, signedRequest(nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("FetchStockHandler returned status %d, expected %d", w.Code, http.StatusOK)
+	}
+
+	expected := `{}` + "\n"
+	if w.Body.String() != expected {
+		t.Errorf("FetchStockHandler returned %s, expected %s", w.Body.String(), expected)
+	}
+}
+
+func TestFetchStockHandlerErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	failing := app.FetchStockHandler(func(req FetchStockRequest) (map[string]int, error) {
+		return nil, errors.New("warehouse unavailable")
+	})
+
+	cases := []struct {
+		target   string
+		expected int
+	}{
+		{"/fetch_stock.json?shop=fooshop.myshopify.com&sku=IPOD2008GREEN", http.StatusUnauthorized},
+		{signedCallbackURL("/fetch_stock.json", url.Values{"shop": {"fooshop.myshopify.com"}, "max_retries": {"many"}}), http.StatusBadRequest},
+		{signedCallbackURL("/fetch_stock.json", url.Values{"shop": {"fooshop.myshopify.com"}}), http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		failing.ServeHTTP(w, httptest.NewRequest("GET", c.target, nil))
+		if w.Code != c.expected {
+			t.Errorf("FetchStockHandler returned status %d for %s, expected %d", w.Code, c.target, c.expected)
+		}
+	}
+}
+
+func TestFetchTrackingNumbersHandler(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var received FetchTrackingNumbersRequest
+	handler := app.FetchTrackingNumbersHandler(func(req FetchTrackingNumbersRequest) (*FetchTrackingNumbersResponse, error) {
+		received = req
+		return &FetchTrackingNumbersResponse{
+			TrackingNumbers: map[string]string{"#1001.1": "qwerty", "#1002.1": "asdfg"},
+			Message:         "Successfully received the tracking numbers",
+			Success:         true,
+		}, nil
+	})
+
+	query := url.Values{
+		"shop":          {"fooshop.myshopify.com"},
+		"order_names[]": {"#1001.1", "#1002.1"},
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", signedCallbackURL("/fetch_tracking_numbers.json", query), nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("FetchTrackingNumbersHandler returned status %d, expected %d", w.Code, http.StatusOK)
+	}
+
+	expected := `{"tracking_numbers":{"#1001.1":"qwerty","#1002.1":"asdfg"},"message":"Successfully received the tracking numbers","success":true}` + "\n"
+	if w.Body.String() != expected {
+		t.Errorf("FetchTrackingNumbersHandler returned %s, expected %s", w.Body.String(), expected)
+	}
+
+	expectedRequest := FetchTrackingNumbersRequest{Shop: "fooshop.myshopify.com", OrderNames: []string{"#1001.1", "#1002.1"}}
+	if !reflect.DeepEqual(received, expectedRequest) {
+		t.Errorf("FetchTrackingNumbersHandler received %+v, expected %+v", received, expectedRequest)
+	}
+}
+
+func TestFetchTrackingNumbersHandlerErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	failing := app.FetchTrackingNumbersHandler(func(req FetchTrackingNumbersRequest) (*FetchTrackingNumbersResponse, error) {
+		return nil, errors.New("tracking unavailable")
+	})
+
+	query := url.Values{"shop": {"fooshop.myshopify.com"}, "order_names[]": {"#1001.1"}}
+	cases := []struct {
+		target   string
+		expected int
+	}{
+		{"/fetch_tracking_numbers.json?" + query.Encode(), http.StatusUnauthorized},
+		{signedCallbackURL("/fetch_tracking_numbers.json", query), http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		failing.ServeHTTP(w, httptest.NewRequest("GET", c.target, nil))
+		if w.Code != c.expected {
+			t.Errorf("FetchTrackingNumbersHandler returned status %d for %s, expected %d", w.Code, c.target, c.expected)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package goshopify
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const gidPrefix = "gid://shopify/"
+
+// Resource kinds of the GraphQL global IDs of the resources in this library,
+// e.g. the kind of gid://shopify/Order/123 is GIDOrder
+const (
+	GIDApplicationCharge          = "AppPurchaseOneTime"
+	GIDArticle                    = "OnlineStoreArticle"
+	GIDBlog                       = "OnlineStoreBlog"
+	GIDCarrierService             = "DeliveryCarrierService"
+	GIDCollection                 = "Collection"
+	GIDComment                    = "Comment"
+	GIDCustomer                   = "Customer"
+	GIDCustomerAddress            = "MailingAddress"
+	GIDDraftOrder                 = "DraftOrder"
+	GIDFulfillment                = "Fulfillment"
+	GIDFulfillmentOrder           = "FulfillmentOrder"
+	GIDFulfillmentService         = "ApiFulfillmentService"
+	GIDGiftCard                   = "GiftCard"
+	GIDImage                      = "ProductImage"
+	GIDInventoryItem              = "InventoryItem"
+	GIDInventoryLevel             = "InventoryLevel"
+	GIDLineItem                   = "LineItem"
+	GIDLocation                   = "Location"
+	GIDMetafield                  = "Metafield"
+	GIDOrder                      = "Order"
+	GIDPage                       = "OnlineStorePage"
+	GIDPriceRule                  = "PriceRule"
+	GIDProduct                    = "Product"
+	GIDRecurringApplicationCharge = "AppSubscription"
+	GIDRedirect                   = "UrlRedirect"
+	GIDRefund                     = "Refund"
+	GIDScriptTag                  = "ScriptTag"
+	GIDShop                       = "Shop"
+	GIDStorefrontAccessToken      = "StorefrontAccessToken"
+	GIDTheme                      = "OnlineStoreTheme"
+	GIDTransaction                = "OrderTransaction"
+	GIDVariant                    = "ProductVariant"
+	GIDWebhook                    = "WebhookSubscription"
+)
+
+// GID is a GraphQL global ID, which identifies a resource in the GraphQL
+// Admin API, e.g. gid://shopify/Order/123. The same ID is returned in the
+// admin_graphql_api_id field of the REST resources.
+type GID struct {
+	Resource string
+	ID       int64
+}
+
+// NewGID returns the GID of the resource of the given kind with the given
+// numeric REST ID.
+func NewGID(resource string, id int64) GID {
+	return GID{Resource: resource, ID: id}
+}
+
+// ParseGID parses a GraphQL global ID such as gid://shopify/Order/123. Query
+// parameters, which Shopify adds to some IDs, are ignored.
+func ParseGID(s string) (GID, error) {
+	if !strings.HasPrefix(s, gidPrefix) {
+		return GID{}, fmt.Errorf("invalid GID %q: missing %s prefix", s, gidPrefix)
+	}
+
+	path := strings.TrimPrefix(s, gidPrefix)
+	if i := strings.Index(path, "?"); i >= 0 {
+		path = path[:i]
+	}
+
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		return GID{}, fmt.Errorf("invalid GID %q: expected %s<resource>/<id>", s, gidPrefix)
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return GID{}, fmt.Errorf("invalid GID %q: %v", s, err)
+	}
+
+	return GID{Resource: parts[0], ID: id}, nil
+}
+
+// String returns the GID formatted as gid://shopify/<resource>/<id>
+func (g GID) String() string {
+	return fmt.Sprintf("%s%s/%d", gidPrefix, g.Resource, g.ID)
+}
+
+// GIDID returns the numeric REST ID of a GraphQL global ID, checking that it
+// identifies a resource of the given kind. This is useful for converting the
+// IDs returned by the GraphQL Admin API to IDs for the REST services.
+func GIDID(resource, s string) (int64, error) {
+	gid, err := ParseGID(s)
+	if err != nil {
+		return 0, err
+	}
+	if gid.Resource != resource {
+		return 0, fmt.Errorf("invalid GID %q: expected a %s", s, resource)
+	}
+	return gid.ID, nil
+}
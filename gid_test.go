@@ -0,0 +1,76 @@
+package goshopify
+
+import "testing"
+
+func TestGIDString(t *testing.T) {
+	cases := []struct {
+		gid      GID
+		expected string
+	}{
+		{NewGID(GIDOrder, 123), "gid://shopify/Order/123"},
+		{NewGID(GIDVariant, 9007199254740993), "gid://shopify/ProductVariant/9007199254740993"},
+		{GID{Resource: "Custom", ID: 1}, "gid://shopify/Custom/1"},
+	}
+
+	for _, c := range cases {
+		actual := c.gid.String()
+		if actual != c.expected {
+			t.Errorf("GID.String(): expected %s, actual %s", c.expected, actual)
+		}
+	}
+}
+
+func TestParseGID(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected GID
+	}{
+		{"gid://shopify/Order/123", GID{GIDOrder, 123}},
+		{"gid://shopify/ProductVariant/9007199254740993", GID{GIDVariant, 9007199254740993}},
+		{"gid://shopify/MailingAddress/207119551?model_name=CustomerAddress", GID{GIDCustomerAddress, 207119551}},
+	}
+
+	for _, c := range cases {
+		actual, err := ParseGID(c.in)
+		if err != nil {
+			t.Errorf("ParseGID(%s): unexpected error %v", c.in, err)
+		}
+		if actual != c.expected {
+			t.Errorf("ParseGID(%s): expected %+v, actual %+v", c.in, c.expected, actual)
+		}
+	}
+}
+
+func TestParseGIDError(t *testing.T) {
+	cases := []string{
+		"",
+		"123",
+		"gid://other/Order/123",
+		"gid://shopify/Order",
+		"gid://shopify//123",
+		"gid://shopify/Order/abc",
+		"gid://shopify/Order/123/456",
+	}
+
+	for _, c := range cases {
+		_, err := ParseGID(c)
+		if err == nil {
+			t.Errorf("ParseGID(%s): expected error, actual nil", c)
+		}
+	}
+}
+
+func TestGIDID(t *testing.T) {
+	id, err := GIDID(GIDOrder, "gid://shopify/Order/123")
+	if err != nil {
+		t.Errorf("GIDID returned error: %v", err)
+	}
+	if id != 123 {
+		t.Errorf("GIDID: expected %d, actual %d", 123, id)
+	}
+
+	_, err = GIDID(GIDProduct, "gid://shopify/Order/123")
+	if err == nil {
+		t.Error("GIDID: expected error for a GID of another resource, actual nil")
+	}
+}
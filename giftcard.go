@@ -0,0 +1,118 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const giftCardsBasePath = "admin/gift_cards"
+
+// GiftCardService is an interface for interfacing with the gift cards
+// endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/plus/gift_card
+type GiftCardService interface {
+	List(interface{}) ([]GiftCard, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*GiftCard, error)
+	Create(GiftCard) (*GiftCard, error)
+	Update(GiftCard) (*GiftCard, error)
+	Disable(int64) (*GiftCard, error)
+	Search(interface{}) ([]GiftCard, error)
+}
+
+// GiftCardServiceOp handles communication with the gift card related methods
+// of the Shopify API.
+type GiftCardServiceOp struct {
+	client *Client
+}
+
+// Statuses of the gift cards to list
+const (
+	GiftCardStatusEnabled  = "enabled"
+	GiftCardStatusDisabled = "disabled"
+)
+
+// GiftCard represents a Shopify gift card. Code is only returned in full when
+// the gift card is created, afterwards only LastCharacters are. ExpiresOn is
+// a date, e.g. 2025-12-31.
+type GiftCard struct {
+	ID                int64            `json:"id,omitempty"`
+	Balance           *decimal.Decimal `json:"balance,omitempty"`
+	InitialValue      *decimal.Decimal `json:"initial_value,omitempty"`
+	Currency          string           `json:"currency,omitempty"`
+	Code              string           `json:"code,omitempty"`
+	LastCharacters    string           `json:"last_characters,omitempty"`
+	Note              string           `json:"note,omitempty"`
+	ExpiresOn         string           `json:"expires_on,omitempty"`
+	TemplateSuffix    string           `json:"template_suffix,omitempty"`
+	CustomerID        int64            `json:"customer_id,omitempty"`
+	OrderID           int64            `json:"order_id,omitempty"`
+	LineItemID        int64            `json:"line_item_id,omitempty"`
+	UserID            int64            `json:"user_id,omitempty"`
+	APIClientID       int64            `json:"api_client_id,omitempty"`
+	DisabledAt        *time.Time       `json:"disabled_at,omitempty"`
+	CreatedAt         *time.Time       `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time       `json:"updated_at,omitempty"`
+	AdminGraphqlAPIID string           `json:"admin_graphql_api_id,omitempty"`
+}
+
+// GiftCardListOptions filters the gift cards to list or count by their
+// status, one of the GiftCardStatus constants
+type GiftCardListOptions struct {
+	ListOptions
+	Status string `url:"status,omitempty"`
+}
+
+// GiftCardSearchOptions are the options of a gift card search, e.g.
+// Query: "last_characters:mnop"
+type GiftCardSearchOptions struct {
+	Query  string `url:"query,omitempty"`
+	Order  string `url:"order,omitempty"`
+	Limit  int    `url:"limit,omitempty"`
+	Fields string `url:"fields,omitempty"`
+}
+
+// giftCards returns the resource for the gift cards endpoints
+func (s *GiftCardServiceOp) giftCards() *Resource[GiftCard] {
+	return NewResource[GiftCard](s.client, giftCardsBasePath, "gift_card", "gift_cards")
+}
+
+// List gift cards
+func (s *GiftCardServiceOp) List(options interface{}) ([]GiftCard, error) {
+	return s.giftCards().List(options)
+}
+
+// Count gift cards
+func (s *GiftCardServiceOp) Count(options interface{}) (int, error) {
+	return s.giftCards().Count(options)
+}
+
+// Get individual gift card
+func (s *GiftCardServiceOp) Get(giftCardID int64, options interface{}) (*GiftCard, error) {
+	return s.giftCards().Get(giftCardID, options)
+}
+
+// Create a new gift card. Shopify generates the code unless Code is set.
+func (s *GiftCardServiceOp) Create(giftCard GiftCard) (*GiftCard, error) {
+	return s.giftCards().Create(giftCard)
+}
+
+// Update an existing gift card. Only the note, expiry date, template suffix
+// and customer of a gift card can be updated.
+func (s *GiftCardServiceOp) Update(giftCard GiftCard) (*GiftCard, error) {
+	return s.giftCards().Update(giftCard.ID, giftCard)
+}
+
+// Disable a gift card. Disabled gift cards can't be enabled again.
+func (s *GiftCardServiceOp) Disable(giftCardID int64) (*GiftCard, error) {
+	data := map[string]GiftCard{"gift_card": {ID: giftCardID}}
+	return s.giftCards().Action(giftCardID, "disable", data)
+}
+
+// Search gift cards
+func (s *GiftCardServiceOp) Search(options interface{}) ([]GiftCard, error) {
+	path := fmt.Sprintf("%s/search", giftCardsBasePath)
+	return NewResource[GiftCard](s.client, path, "gift_card", "gift_cards").List(options)
+}
@@ -0,0 +1,192 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func giftCardTests(t *testing.T, giftCard GiftCard) {
+	// Check that the ID is assigned to the returned gift card
+	expectedID := int64(1035197676)
+	if giftCard.ID != expectedID {
+		t.Errorf("GiftCard.ID returned %+v, expected %+v", giftCard.ID, expectedID)
+	}
+
+	expectedBalance := decimal.RequireFromString("100.00")
+	if giftCard.Balance == nil || !giftCard.Balance.Equals(expectedBalance) {
+		t.Errorf("GiftCard.Balance returned %+v, expected %+v", giftCard.Balance, expectedBalance)
+	}
+	if giftCard.InitialValue == nil || !giftCard.InitialValue.Equals(expectedBalance) {
+		t.Errorf("GiftCard.InitialValue returned %+v, expected %+v", giftCard.InitialValue, expectedBalance)
+	}
+
+	expectedExpiresOn := "2025-12-31"
+	if giftCard.ExpiresOn != expectedExpiresOn {
+		t.Errorf("GiftCard.ExpiresOn returned %+v, expected %+v", giftCard.ExpiresOn, expectedExpiresOn)
+	}
+
+	expectedLastCharacters := "0y0y"
+	if giftCard.LastCharacters != expectedLastCharacters {
+		t.Errorf("GiftCard.LastCharacters returned %+v, expected %+v", giftCard.LastCharacters, expectedLastCharacters)
+	}
+}
+
+func TestGiftCardList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/gift_cards.json?status=enabled",
+		httpmock.NewBytesResponder(200, loadFixture("gift_cards.json")))
+
+	giftCards, err := client.GiftCard.List(GiftCardListOptions{Status: GiftCardStatusEnabled})
+	if err != nil {
+		t.Errorf("GiftCard.List returned error: %v", err)
+	}
+
+	if len(giftCards) != 2 {
+		t.Fatalf("GiftCard.List returned %d gift cards, expected 2", len(giftCards))
+	}
+	giftCardTests(t, giftCards[0])
+}
+
+func TestGiftCardCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/gift_cards/count.json",
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/gift_cards/count.json?status=disabled",
+		httpmock.NewStringResponder(200, `{"count": 1}`))
+
+	cnt, err := client.GiftCard.Count(nil)
+	if err != nil {
+		t.Errorf("GiftCard.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("GiftCard.Count returned %d, expected %d", cnt, expected)
+	}
+
+	cnt, err = client.GiftCard.Count(GiftCardListOptions{Status: GiftCardStatusDisabled})
+	if err != nil {
+		t.Errorf("GiftCard.Count returned error: %v", err)
+	}
+
+	expected = 1
+	if cnt != expected {
+		t.Errorf("GiftCard.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestGiftCardGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/gift_cards/1035197676.json",
+		httpmock.NewBytesResponder(200, loadFixture("gift_card.json")))
+
+	giftCard, err := client.GiftCard.Get(1035197676, nil)
+	if err != nil {
+		t.Errorf("GiftCard.Get returned error: %v", err)
+	}
+
+	giftCardTests(t, *giftCard)
+}
+
+func TestGiftCardCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/gift_cards.json",
+		bodyCheckingResponder(t,
+			`{"gift_card": {"initial_value": "100", "code": "1234567890abcdef0y0y", "note": "Support compensation", "expires_on": "2025-12-31"}}`,
+			201,
+			loadFixture("gift_card.json")))
+
+	giftCard := GiftCard{
+		InitialValue: decimalPtr("100"),
+		Code:         "1234567890abcdef0y0y",
+		Note:         "Support compensation",
+		ExpiresOn:    "2025-12-31",
+	}
+
+	created, err := client.GiftCard.Create(giftCard)
+	if err != nil {
+		t.Errorf("GiftCard.Create returned error: %v", err)
+	}
+
+	giftCardTests(t, *created)
+
+	if created.Code != giftCard.Code {
+		t.Errorf("GiftCard.Code returned %+v, expected %+v", created.Code, giftCard.Code)
+	}
+}
+
+func TestGiftCardUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/gift_cards/1035197676.json",
+		bodyCheckingResponder(t,
+			`{"gift_card": {"id": 1035197676, "note": "Updated note", "expires_on": "2026-06-30"}}`,
+			200,
+			[]byte(`{"gift_card": {"id": 1035197676, "note": "Updated note", "expires_on": "2026-06-30"}}`)))
+
+	giftCard := GiftCard{
+		ID:        1035197676,
+		Note:      "Updated note",
+		ExpiresOn: "2026-06-30",
+	}
+
+	updated, err := client.GiftCard.Update(giftCard)
+	if err != nil {
+		t.Errorf("GiftCard.Update returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(updated, &giftCard) {
+		t.Errorf("GiftCard.Update returned %+v, expected %+v", updated, giftCard)
+	}
+}
+
+func TestGiftCardDisable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/gift_cards/1035197676/disable.json",
+		bodyCheckingResponder(t,
+			`{"gift_card": {"id": 1035197676}}`,
+			200,
+			[]byte(`{"gift_card": {"id": 1035197676, "disabled_at": "2023-02-02T09:10:00-05:00"}}`)))
+
+	giftCard, err := client.GiftCard.Disable(1035197676)
+	if err != nil {
+		t.Fatalf("GiftCard.Disable returned error: %v", err)
+	}
+
+	if giftCard.DisabledAt == nil {
+		t.Errorf("GiftCard.DisabledAt returned %+v, expected a time", giftCard.DisabledAt)
+	}
+}
+
+func TestGiftCardSearch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/gift_cards/search.json?query=last_characters%3A0y0y",
+		httpmock.NewBytesResponder(200, loadFixture("gift_cards.json")))
+
+	giftCards, err := client.GiftCard.Search(GiftCardSearchOptions{Query: "last_characters:0y0y"})
+	if err != nil {
+		t.Errorf("GiftCard.Search returned error: %v", err)
+	}
+
+	if len(giftCards) != 2 {
+		t.Fatalf("GiftCard.Search returned %d gift cards, expected 2", len(giftCards))
+	}
+	giftCardTests(t, giftCards[0])
+}
@@ -0,0 +1,9 @@
+module github.com/youfoodz/go-shopify/v2
+
+go 1.21
+
+require (
+	github.com/google/go-querystring v1.0.0
+	github.com/jarcoal/httpmock v1.3.1
+	github.com/shopspring/decimal v1.3.1
+)
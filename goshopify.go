@@ -5,10 +5,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"net/http"
 	"net/url"
 	"reflect"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -18,7 +19,7 @@ import (
 )
 
 const (
-	UserAgent = "goshopify/1.0.0"
+	UserAgent = "goshopify/2.0.0"
 )
 
 // App represents basic app settings such as Api key, secret, scope, and redirect url.
@@ -51,24 +52,50 @@ type Client struct {
 	Product                    ProductService
 	CustomCollection           CustomCollectionService
 	SmartCollection            SmartCollectionService
+	Collect                    CollectService
+	ProductListing             ProductListingService
+	CollectionListing          CollectionListingService
 	Customer                   CustomerService
 	CustomerAddress            CustomerAddressService
 	Order                      OrderService
+	DraftOrder                 DraftOrderService
+	PriceRule                  PriceRuleService
+	DiscountCode               DiscountCodeService
+	GiftCard                   GiftCardService
+	FulfillmentOrder           FulfillmentOrderService
+	FulfillmentEvent           FulfillmentEventService
+	CarrierService             CarrierServiceService
+	FulfillmentService         FulfillmentServiceService
 	Shop                       ShopService
 	Webhook                    WebhookService
 	Variant                    VariantService
+	InventoryItem              InventoryItemService
+	InventoryLevel             InventoryLevelService
+	Location                   LocationService
 	Image                      ImageService
 	Transaction                TransactionService
+	TenderTransaction          TenderTransactionService
+	Refund                     RefundService
+	OrderRisk                  OrderRiskService
+	Balance                    BalanceService
+	Payout                     PayoutService
+	Dispute                    DisputeService
 	Theme                      ThemeService
 	Asset                      AssetService
 	ScriptTag                  ScriptTagService
 	RecurringApplicationCharge RecurringApplicationChargeService
+	UsageCharge                UsageChargeService
 	Metafield                  MetafieldService
+	Event                      EventService
 	Blog                       BlogService
+	Article                    ArticleService
+	Comment                    CommentService
 	ApplicationCharge          ApplicationChargeService
+	ApplicationCredit          ApplicationCreditService
 	Redirect                   RedirectService
 	Page                       PageService
 	StorefrontAccessToken      StorefrontAccessTokenService
+	AccessScope                AccessScopeService
 }
 
 // A general response error that follows a similar layout to Shopify's response
@@ -113,6 +140,22 @@ type RateLimitError struct {
 	RetryAfter int
 }
 
+// An error specific to a 403 response caused by a missing access scope.
+// Embeds the ResponseError to allow consumers to handle it the same way as a
+// normal ResponseError. Resource is the resource that was requested, e.g.
+// "customers", and Scope is the scope Shopify reported as missing, e.g.
+// "read_customers".
+type MissingScopeError struct {
+	ResponseError
+	Resource string
+	Scope    string
+}
+
+// Matches the scope in messages such as "This action requires read_customers
+// scope" or "[API] This action requires merchant approval for read_customers
+// scope."
+var missingScopeRegexp = regexp.MustCompile(`(\w+) scope`)
+
 // Creates an API request. A relative URL can be provided in urlStr, which will
 // be resolved to the BaseURL of the Client. Relative URLS should always be
 // specified without a preceding slash. If specified, the value pointed to by
@@ -187,24 +230,50 @@ func NewClient(app App, shopName, token string) *Client {
 	c.Product = &ProductServiceOp{client: c}
 	c.CustomCollection = &CustomCollectionServiceOp{client: c}
 	c.SmartCollection = &SmartCollectionServiceOp{client: c}
+	c.Collect = &CollectServiceOp{client: c}
+	c.ProductListing = &ProductListingServiceOp{client: c}
+	c.CollectionListing = &CollectionListingServiceOp{client: c}
 	c.Customer = &CustomerServiceOp{client: c}
 	c.CustomerAddress = &CustomerAddressServiceOp{client: c}
 	c.Order = &OrderServiceOp{client: c}
+	c.DraftOrder = &DraftOrderServiceOp{client: c}
+	c.PriceRule = &PriceRuleServiceOp{client: c}
+	c.DiscountCode = &DiscountCodeServiceOp{client: c}
+	c.GiftCard = &GiftCardServiceOp{client: c}
+	c.FulfillmentOrder = &FulfillmentOrderServiceOp{client: c}
+	c.FulfillmentEvent = &FulfillmentEventServiceOp{client: c}
+	c.CarrierService = &CarrierServiceServiceOp{client: c}
+	c.FulfillmentService = &FulfillmentServiceServiceOp{client: c}
 	c.Shop = &ShopServiceOp{client: c}
 	c.Webhook = &WebhookServiceOp{client: c}
 	c.Variant = &VariantServiceOp{client: c}
+	c.InventoryItem = &InventoryItemServiceOp{client: c}
+	c.InventoryLevel = &InventoryLevelServiceOp{client: c}
+	c.Location = &LocationServiceOp{client: c}
 	c.Image = &ImageServiceOp{client: c}
 	c.Transaction = &TransactionServiceOp{client: c}
+	c.TenderTransaction = &TenderTransactionServiceOp{client: c}
+	c.Refund = &RefundServiceOp{client: c}
+	c.OrderRisk = &OrderRiskServiceOp{client: c}
+	c.Balance = &BalanceServiceOp{client: c}
+	c.Payout = &PayoutServiceOp{client: c}
+	c.Dispute = &DisputeServiceOp{client: c}
 	c.Theme = &ThemeServiceOp{client: c}
 	c.Asset = &AssetServiceOp{client: c}
 	c.ScriptTag = &ScriptTagServiceOp{client: c}
 	c.RecurringApplicationCharge = &RecurringApplicationChargeServiceOp{client: c}
+	c.UsageCharge = &UsageChargeServiceOp{client: c}
 	c.Metafield = &MetafieldServiceOp{client: c}
+	c.Event = &EventServiceOp{client: c}
 	c.Blog = &BlogServiceOp{client: c}
+	c.Article = &ArticleServiceOp{client: c}
+	c.Comment = &CommentServiceOp{client: c}
 	c.ApplicationCharge = &ApplicationChargeServiceOp{client: c}
+	c.ApplicationCredit = &ApplicationCreditServiceOp{client: c}
 	c.Redirect = &RedirectServiceOp{client: c}
 	c.Page = &PageServiceOp{client: c}
 	c.StorefrontAccessToken = &StorefrontAccessTokenServiceOp{client: c}
+	c.AccessScope = &AccessScopeServiceOp{client: c}
 
 	return c
 }
@@ -213,26 +282,32 @@ func NewClient(app App, shopName, token string) *Client {
 // response. It does not make much sense to call Do without a prepared
 // interface instance.
 func (c *Client) Do(req *http.Request, v interface{}) error {
+	_, err := c.do(req, v)
+	return err
+}
+
+// do is Do, but also returns the status code of the response
+func (c *Client) do(req *http.Request, v interface{}) (int, error) {
 	resp, err := c.Client.Do(req)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer resp.Body.Close()
 
 	err = CheckResponseError(resp)
 	if err != nil {
-		return err
+		return resp.StatusCode, err
 	}
 
 	if v != nil {
 		decoder := json.NewDecoder(resp.Body)
 		err := decoder.Decode(&v)
 		if err != nil {
-			return err
+			return resp.StatusCode, err
 		}
 	}
 
-	return nil
+	return resp.StatusCode, nil
 }
 
 func wrapSpecificError(r *http.Response, err ResponseError) error {
@@ -243,12 +318,33 @@ func wrapSpecificError(r *http.Response, err ResponseError) error {
 			RetryAfter:    int(f),
 		}
 	}
+	if err.Status == 403 {
+		if match := missingScopeRegexp.FindStringSubmatch(err.Message); match != nil {
+			return MissingScopeError{
+				ResponseError: err,
+				Resource:      requestedResource(r),
+				Scope:         match[1],
+			}
+		}
+	}
 	if err.Status == 406 {
 		err.Message = "Not acceptable"
 	}
 	return err
 }
 
+// Returns the name of the resource requested by the response's request, e.g.
+// "customers" for admin/customers/1.json.
+func requestedResource(r *http.Response) string {
+	if r.Request == nil || r.Request.URL == nil {
+		return ""
+	}
+	path := strings.TrimPrefix(r.Request.URL.Path, "/")
+	path = strings.TrimPrefix(path, "admin/")
+	resource := strings.SplitN(path, "/", 2)[0]
+	return strings.TrimSuffix(resource, ".json")
+}
+
 func CheckResponseError(r *http.Response) error {
 	if r.StatusCode >= 200 && r.StatusCode < 300 {
 		return nil
@@ -260,7 +356,7 @@ func CheckResponseError(r *http.Response) error {
 		Errors interface{} `json:"errors"`
 	}{}
 
-	bodyBytes, err := ioutil.ReadAll(r.Body)
+	bodyBytes, err := io.ReadAll(r.Body)
 	if err != nil {
 		return err
 	}
@@ -340,14 +436,14 @@ func CheckResponseError(r *http.Response) error {
 type ListOptions struct {
 	Page         int       `url:"page,omitempty"`
 	Limit        int       `url:"limit,omitempty"`
-	SinceID      int       `url:"since_id,omitempty"`
+	SinceID      int64     `url:"since_id,omitempty"`
 	CreatedAtMin time.Time `url:"created_at_min,omitempty"`
 	CreatedAtMax time.Time `url:"created_at_max,omitempty"`
 	UpdatedAtMin time.Time `url:"updated_at_min,omitempty"`
 	UpdatedAtMax time.Time `url:"updated_at_max,omitempty"`
 	Order        string    `url:"order,omitempty"`
 	Fields       string    `url:"fields,omitempty"`
-	IDs          []int     `url:"ids,omitempty,comma"`
+	IDs          []int64   `url:"ids,omitempty,comma"`
 }
 
 // General count options that can be used for most collection counts.
@@ -5,15 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
+	"os"
 	"reflect"
 	"testing"
 	"time"
 
-	"gopkg.in/jarcoal/httpmock.v1"
+	"github.com/jarcoal/httpmock"
 )
 
 var (
@@ -49,13 +50,35 @@ func teardown() {
 }
 
 func loadFixture(filename string) []byte {
-	f, err := ioutil.ReadFile("fixtures/" + filename)
+	f, err := os.ReadFile("fixtures/" + filename)
 	if err != nil {
 		panic(fmt.Sprintf("Cannot load fixture %v", filename))
 	}
 	return f
 }
 
+// bodyCheckingResponder returns a responder that checks that the JSON body
+// of the request equals the expected JSON, where an empty body equals null,
+// and responds with the given body
+func bodyCheckingResponder(t *testing.T, expected string, status int, body []byte) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		var actual, want interface{}
+		data, _ := io.ReadAll(req.Body)
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &actual); err != nil {
+				t.Errorf("Cannot decode request body: %v", err)
+			}
+		}
+		if err := json.Unmarshal([]byte(expected), &want); err != nil {
+			t.Fatalf("Cannot decode expected body: %v", err)
+		}
+		if !reflect.DeepEqual(actual, want) {
+			t.Errorf("Request body was %+v, expected %+v", actual, want)
+		}
+		return httpmock.NewBytesResponse(status, body), nil
+	}
+}
+
 func TestNewClient(t *testing.T) {
 	testClient := NewClient(app, "fooshop", "abcd")
 	expected := "https://fooshop.myshopify.com"
@@ -112,7 +135,7 @@ func TestNewRequest(t *testing.T) {
 	}
 
 	// Test body was JSON encoded
-	body, _ := ioutil.ReadAll(req.Body)
+	body, _ := io.ReadAll(req.Body)
 	if string(body) != outBody {
 		t.Errorf("NewRequest(%v) Body = %v, expected %v", inBody, string(body), outBody)
 	}
@@ -155,7 +178,7 @@ func TestNewRequestForPrivateApp(t *testing.T) {
 	}
 
 	// Test body was JSON encoded
-	body, _ := ioutil.ReadAll(req.Body)
+	body, _ := io.ReadAll(req.Body)
 	if string(body) != outBody {
 		t.Errorf("NewRequest(%v) Body = %v, expected %v", inBody, string(body), outBody)
 	}
@@ -285,6 +308,18 @@ func TestDo(t *testing.T) {
 				Message: "Not acceptable",
 			},
 		},
+		{
+			"admin/customers/9.json",
+			httpmock.NewStringResponder(403, `{"errors":"[API] This action requires merchant approval for read_customers scope."}`),
+			MissingScopeError{
+				ResponseError: ResponseError{
+					Status:  403,
+					Message: "[API] This action requires merchant approval for read_customers scope.",
+				},
+				Resource: "customers",
+				Scope:    "read_customers",
+			},
+		},
 		{
 			"foo/8",
 			httpmock.NewStringResponder(500, "<html></html>"),
@@ -428,7 +463,7 @@ func TestCreateAndDo(t *testing.T) {
 		{
 			"://fooshop.myshopify.com/foo/2",
 			httpmock.NewStringResponder(200, ""),
-			errors.New("parse ://fooshop.myshopify.com/foo/2: missing protocol scheme"),
+			errors.New(`parse "://fooshop.myshopify.com/foo/2": missing protocol scheme`),
 		},
 	}
 
@@ -513,6 +548,17 @@ func TestCheckResponseError(t *testing.T) {
 			httpmock.NewStringResponse(400, `{"errors": { "order": ["order is wrong"] }}`),
 			ResponseError{Status: 400, Message: "order: order is wrong", Errors: []string{"order: order is wrong"}},
 		},
+		{
+			httpmock.NewStringResponse(403, `{"errors": "This action requires read_customers scope"}`),
+			MissingScopeError{
+				ResponseError: ResponseError{Status: 403, Message: "This action requires read_customers scope"},
+				Scope:         "read_customers",
+			},
+		},
+		{
+			httpmock.NewStringResponse(403, `{"errors": "Forbidden"}`),
+			ResponseError{Status: 403, Message: "Forbidden"},
+		},
 		{
 			httpmock.NewStringResponse(400, `{error:bad request}`),
 			errors.New("invalid character 'e' looking for beginning of object key string"),
@@ -9,12 +9,12 @@ import (
 // of the Shopify API.
 // See https://help.shopify.com/api/reference/product_image
 type ImageService interface {
-	List(int, interface{}) ([]Image, error)
-	Count(int, interface{}) (int, error)
-	Get(int, int, interface{}) (*Image, error)
-	Create(int, Image) (*Image, error)
-	Update(int, Image) (*Image, error)
-	Delete(int, int) error
+	List(int64, interface{}) ([]Image, error)
+	Count(int64, interface{}) (int, error)
+	Get(int64, int64, interface{}) (*Image, error)
+	Create(int64, Image) (*Image, error)
+	Update(int64, Image) (*Image, error)
+	Delete(int64, int64) error
 }
 
 // ImageServiceOp handles communication with the image related methods of
@@ -25,49 +25,53 @@ type ImageServiceOp struct {
 
 // Image represents a Shopify product's image.
 type Image struct {
-	ID         int        `json:"id,omitempty"`
-	ProductID  int        `json:"product_id,omitempty"`
-	Position   int        `json:"position,omitempty"`
-	CreatedAt  *time.Time `json:"created_at,omitempty"`
-	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
-	Width      int        `json:"width,omitempty"`
-	Height     int        `json:"height,omitempty"`
-	Src        string     `json:"src,omitempty"`
-	Attachment string     `json:"attachment,omitempty"`
-	Filename   string     `json:"filename,omitempty"`
-	VariantIds []int      `json:"variant_ids,omitempty"`
+	ID                int64      `json:"id,omitempty"`
+	ProductID         int64      `json:"product_id,omitempty"`
+	Position          int        `json:"position,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+	Width             int        `json:"width,omitempty"`
+	Height            int        `json:"height,omitempty"`
+	Src               string     `json:"src,omitempty"`
+	Attachment        string     `json:"attachment,omitempty"`
+	Filename          string     `json:"filename,omitempty"`
+	VariantIds        []int64    `json:"variant_ids,omitempty"`
+	AdminGraphqlAPIID string     `json:"admin_graphql_api_id,omitempty"`
 }
 
 // ImageResource represents the result form the products/X/images/Y.json endpoint
+//
+// Deprecated: ImageService returns and takes an Image directly.
 type ImageResource struct {
 	Image *Image `json:"image"`
 }
 
 // ImagesResource represents the result from the products/X/images.json endpoint
+//
+// Deprecated: ImageService.List returns the images directly.
 type ImagesResource struct {
 	Images []Image `json:"images"`
 }
 
+// images returns the resource for the images endpoints of a product
+func (s *ImageServiceOp) images(productID int64) *Resource[Image] {
+	path := fmt.Sprintf("%s/%d/images", productsBasePath, productID)
+	return NewResource[Image](s.client, path, "image", "images")
+}
+
 // List images
-func (s *ImageServiceOp) List(productID int, options interface{}) ([]Image, error) {
-	path := fmt.Sprintf("%s/%d/images.json", productsBasePath, productID)
-	resource := new(ImagesResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Images, err
+func (s *ImageServiceOp) List(productID int64, options interface{}) ([]Image, error) {
+	return s.images(productID).List(options)
 }
 
 // Count images
-func (s *ImageServiceOp) Count(productID int, options interface{}) (int, error) {
-	path := fmt.Sprintf("%s/%d/images/count.json", productsBasePath, productID)
-	return s.client.Count(path, options)
+func (s *ImageServiceOp) Count(productID int64, options interface{}) (int, error) {
+	return s.images(productID).Count(options)
 }
 
 // Get individual image
-func (s *ImageServiceOp) Get(productID int, imageID int, options interface{}) (*Image, error) {
-	path := fmt.Sprintf("%s/%d/images/%d.json", productsBasePath, productID, imageID)
-	resource := new(ImageResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Image, err
+func (s *ImageServiceOp) Get(productID int64, imageID int64, options interface{}) (*Image, error) {
+	return s.images(productID).Get(imageID, options)
 }
 
 // Create a new image
@@ -83,24 +87,16 @@ func (s *ImageServiceOp) Get(productID int, imageID int, options interface{}) (*
 // Shopify will take the attachment.
 //
 // Shopify will accept Image.Attachment without Image.Filename.
-func (s *ImageServiceOp) Create(productID int, image Image) (*Image, error) {
-	path := fmt.Sprintf("%s/%d/images.json", productsBasePath, productID)
-	wrappedData := ImageResource{Image: &image}
-	resource := new(ImageResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Image, err
+func (s *ImageServiceOp) Create(productID int64, image Image) (*Image, error) {
+	return s.images(productID).Create(image)
 }
 
 // Update an existing image
-func (s *ImageServiceOp) Update(productID int, image Image) (*Image, error) {
-	path := fmt.Sprintf("%s/%d/images/%d.json", productsBasePath, productID, image.ID)
-	wrappedData := ImageResource{Image: &image}
-	resource := new(ImageResource)
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.Image, err
+func (s *ImageServiceOp) Update(productID int64, image Image) (*Image, error) {
+	return s.images(productID).Update(image.ID, image)
 }
 
 // Delete an existing image
-func (s *ImageServiceOp) Delete(productID int, imageID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d/images/%d.json", productsBasePath, productID, imageID))
+func (s *ImageServiceOp) Delete(productID int64, imageID int64) error {
+	return s.images(productID).Delete(imageID)
 }
@@ -0,0 +1,70 @@
+package goshopify
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const inventoryItemsBasePath = "admin/inventory_items"
+
+// InventoryItemService is an interface for interfacing with the inventory
+// items endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/inventory/inventoryitem
+type InventoryItemService interface {
+	List(interface{}) ([]InventoryItem, error)
+	Get(int64, interface{}) (*InventoryItem, error)
+	Update(InventoryItem) (*InventoryItem, error)
+}
+
+// InventoryItemServiceOp handles communication with the inventory item
+// related methods of the Shopify API.
+type InventoryItemServiceOp struct {
+	client *Client
+}
+
+// A struct for all available inventory item list options. Shopify requires
+// the IDs of the inventory items.
+// See: https://help.shopify.com/api/reference/inventory/inventoryitem#index
+type InventoryItemListOptions struct {
+	IDs   []int64 `url:"ids,comma"`
+	Limit int     `url:"limit,omitempty"`
+	Page  int     `url:"page,omitempty"`
+}
+
+// InventoryItem represents the physical good of a variant, whose stock is
+// tracked per location by inventory levels.
+type InventoryItem struct {
+	ID                   int64            `json:"id,omitempty"`
+	SKU                  string           `json:"sku,omitempty"`
+	Cost                 *decimal.Decimal `json:"cost,omitempty"`
+	CountryCodeOfOrigin  string           `json:"country_code_of_origin,omitempty"`
+	ProvinceCodeOfOrigin string           `json:"province_code_of_origin,omitempty"`
+	HarmonizedSystemCode string           `json:"harmonized_system_code,omitempty"`
+	Tracked              *bool            `json:"tracked,omitempty"`
+	RequiresShipping     bool             `json:"requires_shipping,omitempty"`
+	CreatedAt            *time.Time       `json:"created_at,omitempty"`
+	UpdatedAt            *time.Time       `json:"updated_at,omitempty"`
+	AdminGraphqlAPIID    string           `json:"admin_graphql_api_id,omitempty"`
+}
+
+// inventoryItems returns the resource for the inventory items endpoints
+func (s *InventoryItemServiceOp) inventoryItems() *Resource[InventoryItem] {
+	return NewResource[InventoryItem](s.client, inventoryItemsBasePath, "inventory_item", "inventory_items")
+}
+
+// List inventory items
+func (s *InventoryItemServiceOp) List(options interface{}) ([]InventoryItem, error) {
+	return s.inventoryItems().List(options)
+}
+
+// Get individual inventory item
+func (s *InventoryItemServiceOp) Get(inventoryItemID int64, options interface{}) (*InventoryItem, error) {
+	return s.inventoryItems().Get(inventoryItemID, options)
+}
+
+// Update an existing inventory item, e.g. its cost, country of origin or HS
+// code
+func (s *InventoryItemServiceOp) Update(inventoryItem InventoryItem) (*InventoryItem, error) {
+	return s.inventoryItems().Update(inventoryItem.ID, inventoryItem)
+}
@@ -0,0 +1,102 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func inventoryItemTests(t *testing.T, item InventoryItem) {
+	// Check that the ID is assigned to the returned inventory item
+	expectedID := int64(808950810)
+	if item.ID != expectedID {
+		t.Errorf("InventoryItem.ID returned %+v, expected %+v", item.ID, expectedID)
+	}
+
+	expectedCost := decimal.RequireFromString("25.00")
+	if item.Cost == nil || !item.Cost.Equals(expectedCost) {
+		t.Errorf("InventoryItem.Cost returned %+v, expected %+v", item.Cost, expectedCost)
+	}
+
+	expectedCountry := "CN"
+	if item.CountryCodeOfOrigin != expectedCountry {
+		t.Errorf("InventoryItem.CountryCodeOfOrigin returned %+v, expected %+v", item.CountryCodeOfOrigin, expectedCountry)
+	}
+
+	expectedHSCode := "847130"
+	if item.HarmonizedSystemCode != expectedHSCode {
+		t.Errorf("InventoryItem.HarmonizedSystemCode returned %+v, expected %+v", item.HarmonizedSystemCode, expectedHSCode)
+	}
+
+	if item.Tracked == nil || !*item.Tracked {
+		t.Errorf("InventoryItem.Tracked returned %+v, expected %+v", item.Tracked, true)
+	}
+}
+
+func TestInventoryItemList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/inventory_items.json?ids=808950810%2C39072856",
+		httpmock.NewBytesResponder(200, loadFixture("inventory_items.json")))
+
+	items, err := client.InventoryItem.List(InventoryItemListOptions{IDs: []int64{808950810, 39072856}})
+	if err != nil {
+		t.Errorf("InventoryItem.List returned error: %v", err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("InventoryItem.List returned %d items, expected 2", len(items))
+	}
+	inventoryItemTests(t, items[0])
+}
+
+func TestInventoryItemGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/inventory_items/808950810.json",
+		httpmock.NewBytesResponder(200, loadFixture("inventory_item.json")))
+
+	item, err := client.InventoryItem.Get(808950810, nil)
+	if err != nil {
+		t.Errorf("InventoryItem.Get returned error: %v", err)
+	}
+
+	inventoryItemTests(t, *item)
+}
+
+func TestInventoryItemUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/inventory_items/808950810.json",
+		func(req *http.Request) (*http.Response, error) {
+			body := make(map[string]InventoryItem)
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return httpmock.NewStringResponse(400, `{"errors": "bad request"}`), nil
+			}
+			if body["inventory_item"].HarmonizedSystemCode != "847130" {
+				return httpmock.NewStringResponse(422, `{"errors": "unexpected inventory item"}`), nil
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("inventory_item.json")), nil
+		})
+
+	cost := decimal.RequireFromString("25.00")
+	item := InventoryItem{
+		ID:                   808950810,
+		Cost:                 &cost,
+		CountryCodeOfOrigin:  "CN",
+		HarmonizedSystemCode: "847130",
+	}
+
+	updated, err := client.InventoryItem.Update(item)
+	if err != nil {
+		t.Errorf("InventoryItem.Update returned error: %v", err)
+	}
+
+	inventoryItemTests(t, *updated)
+}
@@ -0,0 +1,104 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const inventoryLevelsBasePath = "admin/inventory_levels"
+
+// InventoryLevelService is an interface for interfacing with the inventory
+// levels endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/inventory/inventorylevel
+type InventoryLevelService interface {
+	List(interface{}) ([]InventoryLevel, error)
+	Adjust(int64, int64, int) (*InventoryLevel, error)
+	Set(int64, int64, int, bool) (*InventoryLevel, error)
+	Connect(int64, int64, bool) (*InventoryLevel, error)
+	Delete(int64, int64) error
+}
+
+// InventoryLevelServiceOp handles communication with the inventory level
+// related methods of the Shopify API.
+type InventoryLevelServiceOp struct {
+	client *Client
+}
+
+// A struct for all available inventory level list options. Shopify requires
+// either the inventory item IDs or the location IDs.
+// See: https://help.shopify.com/api/reference/inventory/inventorylevel#index
+type InventoryLevelListOptions struct {
+	InventoryItemIDs []int64   `url:"inventory_item_ids,omitempty,comma"`
+	LocationIDs      []int64   `url:"location_ids,omitempty,comma"`
+	Limit            int       `url:"limit,omitempty"`
+	Page             int       `url:"page,omitempty"`
+	UpdatedAtMin     time.Time `url:"updated_at_min,omitempty"`
+}
+
+// InventoryLevel represents the available quantity of an inventory item at a
+// location
+type InventoryLevel struct {
+	InventoryItemID   int64      `json:"inventory_item_id,omitempty"`
+	LocationID        int64      `json:"location_id,omitempty"`
+	Available         int        `json:"available"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+	AdminGraphqlAPIID string     `json:"admin_graphql_api_id,omitempty"`
+}
+
+// inventoryLevels returns the resource for the inventory levels endpoints
+func (s *InventoryLevelServiceOp) inventoryLevels() *Resource[InventoryLevel] {
+	return NewResource[InventoryLevel](s.client, inventoryLevelsBasePath, "inventory_level", "inventory_levels")
+}
+
+// List inventory levels
+func (s *InventoryLevelServiceOp) List(options interface{}) ([]InventoryLevel, error) {
+	return s.inventoryLevels().List(options)
+}
+
+// Adjust the available quantity of an inventory item at a location by the
+// given amount, which can be negative
+func (s *InventoryLevelServiceOp) Adjust(inventoryItemID, locationID int64, adjustment int) (*InventoryLevel, error) {
+	data := struct {
+		InventoryItemID     int64 `json:"inventory_item_id"`
+		LocationID          int64 `json:"location_id"`
+		AvailableAdjustment int   `json:"available_adjustment"`
+	}{inventoryItemID, locationID, adjustment}
+	return s.inventoryLevels().CollectionAction("adjust", data)
+}
+
+// Set the available quantity of an inventory item at a location. The
+// inventory item is connected to the location if it isn't yet. With
+// disconnectIfNecessary, Shopify disconnects it from a fulfillment service
+// location it can't be stocked at together with this location.
+func (s *InventoryLevelServiceOp) Set(inventoryItemID, locationID int64, available int, disconnectIfNecessary bool) (*InventoryLevel, error) {
+	data := struct {
+		InventoryItemID       int64 `json:"inventory_item_id"`
+		LocationID            int64 `json:"location_id"`
+		Available             int   `json:"available"`
+		DisconnectIfNecessary bool  `json:"disconnect_if_necessary,omitempty"`
+	}{inventoryItemID, locationID, available, disconnectIfNecessary}
+	return s.inventoryLevels().CollectionAction("set", data)
+}
+
+// Connect an inventory item to a location. With relocateIfNecessary, Shopify
+// moves the stock of a fulfillment service location the inventory item can't
+// be stocked at together with this location.
+func (s *InventoryLevelServiceOp) Connect(inventoryItemID, locationID int64, relocateIfNecessary bool) (*InventoryLevel, error) {
+	data := struct {
+		InventoryItemID     int64 `json:"inventory_item_id"`
+		LocationID          int64 `json:"location_id"`
+		RelocateIfNecessary bool  `json:"relocate_if_necessary,omitempty"`
+	}{inventoryItemID, locationID, relocateIfNecessary}
+	return s.inventoryLevels().CollectionAction("connect", data)
+}
+
+// Delete the inventory level of an inventory item at a location, which
+// disconnects the inventory item from the location
+func (s *InventoryLevelServiceOp) Delete(inventoryItemID, locationID int64) error {
+	path := fmt.Sprintf("%s.json", inventoryLevelsBasePath)
+	options := struct {
+		InventoryItemID int64 `url:"inventory_item_id"`
+		LocationID      int64 `url:"location_id"`
+	}{inventoryItemID, locationID}
+	return s.client.CreateAndDo("DELETE", path, nil, options, nil)
+}
@@ -0,0 +1,132 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+)
+
+// inventoryLevelResponder checks that the request body matches the expected
+// body and responds with the inventory level fixture
+func inventoryLevelResponder(t *testing.T, expected map[string]interface{}) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		body := make(map[string]interface{})
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return httpmock.NewStringResponse(400, `{"errors": "bad request"}`), nil
+		}
+		if !reflect.DeepEqual(body, expected) {
+			t.Errorf("InventoryLevel request body was %+v, expected %+v", body, expected)
+		}
+		return httpmock.NewBytesResponse(200, loadFixture("inventory_level.json")), nil
+	}
+}
+
+func inventoryLevelTests(t *testing.T, level InventoryLevel) {
+	expectedItemID := int64(808950810)
+	if level.InventoryItemID != expectedItemID {
+		t.Errorf("InventoryLevel.InventoryItemID returned %+v, expected %+v", level.InventoryItemID, expectedItemID)
+	}
+
+	expectedLocationID := int64(487838322)
+	if level.LocationID != expectedLocationID {
+		t.Errorf("InventoryLevel.LocationID returned %+v, expected %+v", level.LocationID, expectedLocationID)
+	}
+
+	expectedAvailable := 5
+	if level.Available != expectedAvailable {
+		t.Errorf("InventoryLevel.Available returned %+v, expected %+v", level.Available, expectedAvailable)
+	}
+}
+
+func TestInventoryLevelList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/inventory_levels.json?location_ids=487838322",
+		httpmock.NewBytesResponder(200, loadFixture("inventory_levels.json")))
+
+	levels, err := client.InventoryLevel.List(InventoryLevelListOptions{LocationIDs: []int64{487838322}})
+	if err != nil {
+		t.Errorf("InventoryLevel.List returned error: %v", err)
+	}
+
+	if len(levels) != 2 {
+		t.Fatalf("InventoryLevel.List returned %d levels, expected 2", len(levels))
+	}
+	if levels[1].Available != 27 {
+		t.Errorf("InventoryLevel.Available returned %+v, expected %+v", levels[1].Available, 27)
+	}
+}
+
+func TestInventoryLevelAdjust(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/inventory_levels/adjust.json",
+		inventoryLevelResponder(t, map[string]interface{}{
+			"inventory_item_id":    float64(808950810),
+			"location_id":          float64(487838322),
+			"available_adjustment": float64(-4),
+		}))
+
+	level, err := client.InventoryLevel.Adjust(808950810, 487838322, -4)
+	if err != nil {
+		t.Errorf("InventoryLevel.Adjust returned error: %v", err)
+	}
+
+	inventoryLevelTests(t, *level)
+}
+
+func TestInventoryLevelSet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/inventory_levels/set.json",
+		inventoryLevelResponder(t, map[string]interface{}{
+			"inventory_item_id":       float64(808950810),
+			"location_id":             float64(487838322),
+			"available":               float64(5),
+			"disconnect_if_necessary": true,
+		}))
+
+	level, err := client.InventoryLevel.Set(808950810, 487838322, 5, true)
+	if err != nil {
+		t.Errorf("InventoryLevel.Set returned error: %v", err)
+	}
+
+	inventoryLevelTests(t, *level)
+}
+
+func TestInventoryLevelConnect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/inventory_levels/connect.json",
+		inventoryLevelResponder(t, map[string]interface{}{
+			"inventory_item_id": float64(808950810),
+			"location_id":       float64(487838322),
+		}))
+
+	level, err := client.InventoryLevel.Connect(808950810, 487838322, false)
+	if err != nil {
+		t.Errorf("InventoryLevel.Connect returned error: %v", err)
+	}
+
+	inventoryLevelTests(t, *level)
+}
+
+func TestInventoryLevelDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/inventory_levels.json?inventory_item_id=808950810&location_id=487838322",
+		httpmock.NewStringResponder(204, ""))
+
+	err := client.InventoryLevel.Delete(808950810, 487838322)
+	if err != nil {
+		t.Errorf("InventoryLevel.Delete returned error: %v", err)
+	}
+}
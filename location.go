@@ -0,0 +1,71 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const locationsBasePath = "admin/locations"
+
+// LocationService is an interface for interfacing with the locations
+// endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/inventory/location
+type LocationService interface {
+	List(interface{}) ([]Location, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*Location, error)
+	ListInventoryLevels(int64, interface{}) ([]InventoryLevel, error)
+}
+
+// LocationServiceOp handles communication with the location related methods
+// of the Shopify API.
+type LocationServiceOp struct {
+	client *Client
+}
+
+// Location represents a Shopify location, e.g. a warehouse or a retail store
+type Location struct {
+	ID                int64      `json:"id,omitempty"`
+	Name              string     `json:"name,omitempty"`
+	Address1          string     `json:"address1,omitempty"`
+	Address2          string     `json:"address2,omitempty"`
+	City              string     `json:"city,omitempty"`
+	Zip               string     `json:"zip,omitempty"`
+	Province          string     `json:"province,omitempty"`
+	ProvinceCode      string     `json:"province_code,omitempty"`
+	Country           string     `json:"country,omitempty"`
+	CountryCode       string     `json:"country_code,omitempty"`
+	CountryName       string     `json:"country_name,omitempty"`
+	Phone             string     `json:"phone,omitempty"`
+	Legacy            bool       `json:"legacy,omitempty"`
+	Active            bool       `json:"active,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+	AdminGraphqlAPIID string     `json:"admin_graphql_api_id,omitempty"`
+}
+
+// locations returns the resource for the locations endpoints
+func (s *LocationServiceOp) locations() *Resource[Location] {
+	return NewResource[Location](s.client, locationsBasePath, "location", "locations")
+}
+
+// List locations
+func (s *LocationServiceOp) List(options interface{}) ([]Location, error) {
+	return s.locations().List(options)
+}
+
+// Count locations
+func (s *LocationServiceOp) Count(options interface{}) (int, error) {
+	return s.locations().Count(options)
+}
+
+// Get individual location
+func (s *LocationServiceOp) Get(locationID int64, options interface{}) (*Location, error) {
+	return s.locations().Get(locationID, options)
+}
+
+// ListInventoryLevels lists the inventory levels of a location
+func (s *LocationServiceOp) ListInventoryLevels(locationID int64, options interface{}) ([]InventoryLevel, error) {
+	path := fmt.Sprintf("%s/%d/inventory_levels", locationsBasePath, locationID)
+	return NewResource[InventoryLevel](s.client, path, "inventory_level", "inventory_levels").List(options)
+}
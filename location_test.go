@@ -0,0 +1,94 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+)
+
+func locationTests(t *testing.T, location Location) {
+	// Check that the ID is assigned to the returned location
+	expectedID := int64(487838322)
+	if location.ID != expectedID {
+		t.Errorf("Location.ID returned %+v, expected %+v", location.ID, expectedID)
+	}
+
+	expectedName := "Fifth Avenue AppleStore"
+	if location.Name != expectedName {
+		t.Errorf("Location.Name returned %+v, expected %+v", location.Name, expectedName)
+	}
+
+	if !location.Active {
+		t.Errorf("Location.Active returned %+v, expected %+v", location.Active, true)
+	}
+}
+
+func TestLocationList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/locations.json",
+		httpmock.NewBytesResponder(200, loadFixture("locations.json")))
+
+	locations, err := client.Location.List(nil)
+	if err != nil {
+		t.Errorf("Location.List returned error: %v", err)
+	}
+
+	if len(locations) != 2 {
+		t.Fatalf("Location.List returned %d locations, expected 2", len(locations))
+	}
+	locationTests(t, locations[0])
+}
+
+func TestLocationCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/locations/count.json",
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.Location.Count(nil)
+	if err != nil {
+		t.Errorf("Location.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("Location.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestLocationGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/locations/487838322.json",
+		httpmock.NewBytesResponder(200, loadFixture("location.json")))
+
+	location, err := client.Location.Get(487838322, nil)
+	if err != nil {
+		t.Errorf("Location.Get returned error: %v", err)
+	}
+
+	locationTests(t, *location)
+}
+
+func TestLocationListInventoryLevels(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/locations/487838322/inventory_levels.json",
+		httpmock.NewStringResponder(200, `{"inventory_levels": [{"inventory_item_id":1,"location_id":487838322,"available":3}]}`))
+
+	levels, err := client.Location.ListInventoryLevels(487838322, nil)
+	if err != nil {
+		t.Errorf("Location.ListInventoryLevels returned error: %v", err)
+	}
+
+	expected := []InventoryLevel{{InventoryItemID: 1, LocationID: 487838322, Available: 3}}
+	if !reflect.DeepEqual(levels, expected) {
+		t.Errorf("Location.ListInventoryLevels returned %+v, expected %+v", levels, expected)
+	}
+}
@@ -1,9 +1,6 @@
 package goshopify
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
 
 // MetafieldService is an interface for interfacing with the metafield endpoints
 // of the Shopify API.
@@ -11,22 +8,22 @@ import (
 type MetafieldService interface {
 	List(interface{}) ([]Metafield, error)
 	Count(interface{}) (int, error)
-	Get(int, interface{}) (*Metafield, error)
+	Get(int64, interface{}) (*Metafield, error)
 	Create(Metafield) (*Metafield, error)
 	Update(Metafield) (*Metafield, error)
-	Delete(int) error
+	Delete(int64) error
 }
 
 // MetafieldsService is an interface for other Shopify resources
 // to interface with the metafield endpoints of the Shopify API.
 // https://help.shopify.com/api/reference/metafield
 type MetafieldsService interface {
-	ListMetafields(int, interface{}) ([]Metafield, error)
-	CountMetafields(int, interface{}) (int, error)
-	GetMetafield(int, int, interface{}) (*Metafield, error)
-	CreateMetafield(int, Metafield) (*Metafield, error)
-	UpdateMetafield(int, Metafield) (*Metafield, error)
-	DeleteMetafield(int, int) error
+	ListMetafields(int64, interface{}) ([]Metafield, error)
+	CountMetafields(int64, interface{}) (int, error)
+	GetMetafield(int64, int64, interface{}) (*Metafield, error)
+	CreateMetafield(int64, Metafield) (*Metafield, error)
+	UpdateMetafield(int64, Metafield) (*Metafield, error)
+	DeleteMetafield(int64, int64) error
 }
 
 // MetafieldServiceOp handles communication with the metafield
@@ -34,80 +31,71 @@ type MetafieldsService interface {
 type MetafieldServiceOp struct {
 	client     *Client
 	resource   string
-	resourceID int
+	resourceID int64
 }
 
 // Metafield represents a Shopify metafield.
 type Metafield struct {
-	ID            int         `json:"id,omitempty"`
-	Key           string      `json:"key,omitempty"`
-	Value         interface{} `json:"value,omitempty"`
-	ValueType     string      `json:"value_type,omitempty"`
-	Namespace     string      `json:"namespace,omitempty"`
-	Description   string      `json:"description,omitempty"`
-	OwnerId       int         `json:"owner_id,omitempty"`
-	CreatedAt     *time.Time  `json:"created_at,omitempty"`
-	UpdatedAt     *time.Time  `json:"updated_at,omitempty"`
-	OwnerResource string      `json:"owner_resource,omitempty"`
+	ID                int64       `json:"id,omitempty"`
+	Key               string      `json:"key,omitempty"`
+	Value             interface{} `json:"value,omitempty"`
+	ValueType         string      `json:"value_type,omitempty"`
+	Namespace         string      `json:"namespace,omitempty"`
+	Description       string      `json:"description,omitempty"`
+	OwnerId           int64       `json:"owner_id,omitempty"`
+	CreatedAt         *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time  `json:"updated_at,omitempty"`
+	OwnerResource     string      `json:"owner_resource,omitempty"`
+	AdminGraphqlAPIID string      `json:"admin_graphql_api_id,omitempty"`
 }
 
 // MetafieldResource represents the result from the metafields/X.json endpoint
+//
+// Deprecated: MetafieldService returns and takes a Metafield directly.
 type MetafieldResource struct {
 	Metafield *Metafield `json:"metafield"`
 }
 
 // MetafieldsResource represents the result from the metafields.json endpoint
+//
+// Deprecated: MetafieldService.List returns the metafields directly.
 type MetafieldsResource struct {
 	Metafields []Metafield `json:"metafields"`
 }
 
+// metafields returns the resource for the metafields endpoints, scoped to
+// the resource of the service if it has one.
+func (s *MetafieldServiceOp) metafields() *Resource[Metafield] {
+	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
+	return NewResource[Metafield](s.client, prefix, "metafield", "metafields")
+}
+
 // List metafields
 func (s *MetafieldServiceOp) List(options interface{}) ([]Metafield, error) {
-	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s.json", prefix)
-	resource := new(MetafieldsResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Metafields, err
+	return s.metafields().List(options)
 }
 
 // Count metafields
 func (s *MetafieldServiceOp) Count(options interface{}) (int, error) {
-	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s/count.json", prefix)
-	return s.client.Count(path, options)
+	return s.metafields().Count(options)
 }
 
 // Get individual metafield
-func (s *MetafieldServiceOp) Get(metafieldID int, options interface{}) (*Metafield, error) {
-	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s/%d.json", prefix, metafieldID)
-	resource := new(MetafieldResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Metafield, err
+func (s *MetafieldServiceOp) Get(metafieldID int64, options interface{}) (*Metafield, error) {
+	return s.metafields().Get(metafieldID, options)
 }
 
 // Create a new metafield
 func (s *MetafieldServiceOp) Create(metafield Metafield) (*Metafield, error) {
-	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s.json", prefix)
-	wrappedData := MetafieldResource{Metafield: &metafield}
-	resource := new(MetafieldResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Metafield, err
+	return s.metafields().Create(metafield)
 }
 
 // Update an existing metafield
 func (s *MetafieldServiceOp) Update(metafield Metafield) (*Metafield, error) {
-	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
-	path := fmt.Sprintf("%s/%d.json", prefix, metafield.ID)
-	wrappedData := MetafieldResource{Metafield: &metafield}
-	resource := new(MetafieldResource)
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.Metafield, err
+	return s.metafields().Update(metafield.ID, metafield)
 }
 
 // Delete an existing metafield
-func (s *MetafieldServiceOp) Delete(metafieldID int) error {
-	prefix := MetafieldPathPrefix(s.resource, s.resourceID)
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", prefix, metafieldID))
+func (s *MetafieldServiceOp) Delete(metafieldID int64) error {
+	return s.metafields().Delete(metafieldID)
 }
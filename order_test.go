@@ -1,12 +1,14 @@
 package goshopify
 
 import (
+	"encoding/json"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
 
+	httpmock "github.com/jarcoal/httpmock"
 	"github.com/shopspring/decimal"
-	httpmock "gopkg.in/jarcoal/httpmock.v1"
 )
 
 func orderTests(t *testing.T, order Order) {
@@ -132,9 +134,58 @@ func TestOrderGet(t *testing.T) {
 		t.Errorf("Order.CancelledAt returned %+v, expected %+v", order.CancelledAt, d)
 	}
 
+	// Check that the GraphQL ID is parsed
+	expectedGID := "gid://shopify/Order/123456"
+	if order.AdminGraphqlAPIID != expectedGID {
+		t.Errorf("Order.AdminGraphqlAPIID returned %+v, expected %+v", order.AdminGraphqlAPIID, expectedGID)
+	}
+
 	orderTests(t, *order)
 }
 
+func TestOrderGetLargeIDs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders/9007199254740993.json",
+		httpmock.NewBytesResponder(200, loadFixture("order_large_ids.json")))
+
+	order, err := client.Order.Get(9007199254740993, nil)
+	if err != nil {
+		t.Fatalf("Order.Get returned error: %v", err)
+	}
+
+	// IDs above 2^53 can't be represented exactly by a float64
+	parentID := int64(9007199254741005)
+	expected := Order{
+		ID:       9007199254740993,
+		AppID:    9007199254740995,
+		Customer: &Customer{ID: 9223372036854775807},
+		LineItems: []LineItem{
+			{ID: 9007199254740997, ProductID: 9007199254740999, VariantID: 9007199254741001},
+		},
+		Transactions: []Transaction{
+			{ID: 9007199254741003, OrderID: 9007199254740993, ParentID: &parentID},
+		},
+	}
+	if !reflect.DeepEqual(*order, expected) {
+		t.Errorf("Order.Get returned %+v, expected %+v", *order, expected)
+	}
+
+	// Encoding the order again must not lose any precision
+	data, err := json.Marshal(order)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	roundTripped := Order{}
+	if err := json.Unmarshal(data, &roundTripped); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if !reflect.DeepEqual(roundTripped, expected) {
+		t.Errorf("round-tripped order is %+v, expected %+v", roundTripped, expected)
+	}
+}
+
 func TestOrderGetWithTransactions(t *testing.T) {
 	setup()
 	defer teardown()
@@ -223,6 +274,129 @@ func TestOrderCreate(t *testing.T) {
 	}
 }
 
+func TestOrderUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/orders/123456.json",
+		httpmock.NewBytesResponder(200, loadFixture("order.json")))
+
+	order := Order{
+		ID:   123456,
+		Tags: "vip",
+	}
+
+	o, err := client.Order.Update(order)
+	if err != nil {
+		t.Errorf("Order.Update returned error: %v", err)
+	}
+
+	orderTests(t, *o)
+}
+
+func TestOrderDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/orders/123456.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Order.Delete(123456)
+	if err != nil {
+		t.Errorf("Order.Delete returned error: %v", err)
+	}
+}
+
+func TestOrderCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/orders/123456/cancel.json",
+		func(req *http.Request) (*http.Response, error) {
+			options := OrderCancelOptions{}
+			if err := json.NewDecoder(req.Body).Decode(&options); err != nil {
+				return httpmock.NewStringResponse(400, `{"errors": "bad request"}`), nil
+			}
+			if options.Reason != OrderCancelReasonCustomer || !options.Restock || !options.Email {
+				return httpmock.NewStringResponse(422, `{"errors": "unexpected options"}`), nil
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("order.json")), nil
+		})
+
+	options := OrderCancelOptions{
+		Reason:  OrderCancelReasonCustomer,
+		Restock: true,
+		Email:   true,
+	}
+
+	o, err := client.Order.Cancel(123456, options)
+	if err != nil {
+		t.Fatalf("Order.Cancel returned error: %v", err)
+	}
+
+	d := time.Date(2016, time.May, 17, 8, 14, 36, 0, time.UTC)
+	if o.CancelledAt == nil || !d.Equal(*o.CancelledAt) {
+		t.Errorf("Order.CancelledAt returned %+v, expected %+v", o.CancelledAt, d)
+	}
+
+	if o.CancelReason != OrderCancelReasonCustomer {
+		t.Errorf("Order.CancelReason returned %+v, expected %+v", o.CancelReason, OrderCancelReasonCustomer)
+	}
+}
+
+func TestOrderClose(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/orders/123456/close.json",
+		httpmock.NewBytesResponder(200, loadFixture("order_closed.json")))
+
+	o, err := client.Order.Close(123456)
+	if err != nil {
+		t.Fatalf("Order.Close returned error: %v", err)
+	}
+
+	d := time.Date(2016, time.May, 18, 14, 0, 0, 0, time.UTC)
+	if o.ClosedAt == nil || !d.Equal(*o.ClosedAt) {
+		t.Errorf("Order.ClosedAt returned %+v, expected %+v", o.ClosedAt, d)
+	}
+}
+
+func TestOrderOpen(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/orders/123456/open.json",
+		httpmock.NewBytesResponder(200, loadFixture("order.json")))
+
+	o, err := client.Order.Open(123456)
+	if err != nil {
+		t.Fatalf("Order.Open returned error: %v", err)
+	}
+
+	if o.ClosedAt != nil {
+		t.Errorf("Order.ClosedAt returned %+v, expected %+v", o.ClosedAt, nil)
+	}
+}
+
+func TestOrderListEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders/450789469/events.json?verb=placed",
+		httpmock.NewStringResponder(200, `{"events": [{"id": 1, "subject_id": 450789469, "subject_type": "Order", "verb": "placed"}]}`))
+
+	events, err := client.Order.ListEvents(450789469, EventListOptions{Verb: EventVerbPlaced})
+	if err != nil {
+		t.Errorf("Order.ListEvents() returned error: %v", err)
+	}
+
+	expected := []Event{{ID: 1, SubjectID: 450789469, SubjectType: EventSubjectTypeOrder, Verb: EventVerbPlaced}}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("Order.ListEvents() returned %+v, expected %+v", events, expected)
+	}
+}
+
 func TestOrderListMetafields(t *testing.T) {
 	setup()
 	defer teardown()
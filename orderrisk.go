@@ -0,0 +1,80 @@
+package goshopify
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// OrderRiskService is an interface for interfacing with the order risks
+// endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/orders/order-risk
+type OrderRiskService interface {
+	List(int64, interface{}) ([]OrderRisk, error)
+	Get(int64, int64, interface{}) (*OrderRisk, error)
+	Create(int64, OrderRisk) (*OrderRisk, error)
+	Update(int64, OrderRisk) (*OrderRisk, error)
+	Delete(int64, int64) error
+}
+
+// OrderRiskServiceOp handles communication with the order risk related
+// methods of the Shopify API.
+type OrderRiskServiceOp struct {
+	client *Client
+}
+
+// Recommendations of order risks
+const (
+	OrderRiskRecommendationAccept      = "accept"
+	OrderRiskRecommendationInvestigate = "investigate"
+	OrderRiskRecommendationCancel      = "cancel"
+)
+
+// OrderRisk represents a Shopify order risk, the result of a fraud check of
+// an order. Recommendation is one of the OrderRiskRecommendation constants,
+// Score is between 0.0 and 1.0. The risk is only shown in the admin if
+// Display is set, and CauseCancel is set when the risk caused the order to
+// be cancelled.
+type OrderRisk struct {
+	ID              int64            `json:"id,omitempty"`
+	OrderID         int64            `json:"order_id,omitempty"`
+	CheckoutID      int64            `json:"checkout_id,omitempty"`
+	Message         string           `json:"message,omitempty"`
+	MerchantMessage string           `json:"merchant_message,omitempty"`
+	Recommendation  string           `json:"recommendation,omitempty"`
+	Score           *decimal.Decimal `json:"score,omitempty"`
+	Source          string           `json:"source,omitempty"`
+	CauseCancel     *bool            `json:"cause_cancel,omitempty"`
+	Display         *bool            `json:"display,omitempty"`
+}
+
+// risks returns the resource for the risks endpoints of an order
+func (s *OrderRiskServiceOp) risks(orderID int64) *Resource[OrderRisk] {
+	path := fmt.Sprintf("%s/%d/risks", ordersBasePath, orderID)
+	return NewResource[OrderRisk](s.client, path, "risk", "risks")
+}
+
+// List order risks
+func (s *OrderRiskServiceOp) List(orderID int64, options interface{}) ([]OrderRisk, error) {
+	return s.risks(orderID).List(options)
+}
+
+// Get individual order risk
+func (s *OrderRiskServiceOp) Get(orderID int64, riskID int64, options interface{}) (*OrderRisk, error) {
+	return s.risks(orderID).Get(riskID, options)
+}
+
+// Create a new order risk
+func (s *OrderRiskServiceOp) Create(orderID int64, risk OrderRisk) (*OrderRisk, error) {
+	return s.risks(orderID).Create(risk)
+}
+
+// Update an existing order risk
+func (s *OrderRiskServiceOp) Update(orderID int64, risk OrderRisk) (*OrderRisk, error) {
+	return s.risks(orderID).Update(risk.ID, risk)
+}
+
+// Delete an existing order risk
+func (s *OrderRiskServiceOp) Delete(orderID int64, riskID int64) error {
+	return s.risks(orderID).Delete(riskID)
+}
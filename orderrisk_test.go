@@ -0,0 +1,131 @@
+package goshopify
+
+import (
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func orderRiskTests(t *testing.T, risk OrderRisk) {
+	expectedID := int64(284138680)
+	if risk.ID != expectedID {
+		t.Errorf("OrderRisk.ID returned %+v, expected %+v", risk.ID, expectedID)
+	}
+
+	expectedOrderID := int64(450789469)
+	if risk.OrderID != expectedOrderID {
+		t.Errorf("OrderRisk.OrderID returned %+v, expected %+v", risk.OrderID, expectedOrderID)
+	}
+
+	if risk.Recommendation != OrderRiskRecommendationCancel {
+		t.Errorf("OrderRisk.Recommendation returned %+v, expected %+v", risk.Recommendation, OrderRiskRecommendationCancel)
+	}
+
+	expectedScore := decimal.RequireFromString("1.0")
+	if risk.Score == nil || !risk.Score.Equals(expectedScore) {
+		t.Errorf("OrderRisk.Score returned %+v, expected %+v", risk.Score, expectedScore)
+	}
+
+	if risk.Display == nil || !*risk.Display || risk.CauseCancel == nil || !*risk.CauseCancel {
+		t.Errorf("OrderRisk.Display and CauseCancel returned %v and %v, expected true", risk.Display, risk.CauseCancel)
+	}
+}
+
+func TestOrderRiskList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders/450789469/risks.json",
+		httpmock.NewBytesResponder(200, loadFixture("order_risks.json")))
+
+	risks, err := client.OrderRisk.List(450789469, nil)
+	if err != nil {
+		t.Fatalf("OrderRisk.List returned error: %v", err)
+	}
+
+	if len(risks) != 2 {
+		t.Fatalf("OrderRisk.List returned %d order risks, expected 2", len(risks))
+	}
+	orderRiskTests(t, risks[0])
+}
+
+func TestOrderRiskGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders/450789469/risks/284138680.json",
+		httpmock.NewBytesResponder(200, loadFixture("order_risk.json")))
+
+	risk, err := client.OrderRisk.Get(450789469, 284138680, nil)
+	if err != nil {
+		t.Fatalf("OrderRisk.Get returned error: %v", err)
+	}
+
+	orderRiskTests(t, *risk)
+}
+
+func TestOrderRiskCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/orders/450789469/risks.json",
+		bodyCheckingResponder(t,
+			`{"risk": {"message": "This order came from an anonymous proxy", "recommendation": "cancel", "score": "1", "source": "External", "cause_cancel": true, "display": true}}`,
+			201,
+			loadFixture("order_risk.json")))
+
+	causeCancel, display := true, true
+	risk := OrderRisk{
+		Message:        "This order came from an anonymous proxy",
+		Recommendation: OrderRiskRecommendationCancel,
+		Score:          decimalPtr("1"),
+		Source:         "External",
+		CauseCancel:    &causeCancel,
+		Display:        &display,
+	}
+
+	created, err := client.OrderRisk.Create(450789469, risk)
+	if err != nil {
+		t.Fatalf("OrderRisk.Create returned error: %v", err)
+	}
+
+	orderRiskTests(t, *created)
+}
+
+func TestOrderRiskUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/orders/450789469/risks/284138680.json",
+		bodyCheckingResponder(t,
+			`{"risk": {"id": 284138680, "recommendation": "cancel", "score": "1"}}`,
+			200,
+			loadFixture("order_risk.json")))
+
+	risk := OrderRisk{
+		ID:             284138680,
+		Recommendation: OrderRiskRecommendationCancel,
+		Score:          decimalPtr("1"),
+	}
+
+	updated, err := client.OrderRisk.Update(450789469, risk)
+	if err != nil {
+		t.Fatalf("OrderRisk.Update returned error: %v", err)
+	}
+
+	orderRiskTests(t, *updated)
+}
+
+func TestOrderRiskDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/orders/450789469/risks/284138680.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.OrderRisk.Delete(450789469, 284138680)
+	if err != nil {
+		t.Errorf("OrderRisk.Delete returned error: %v", err)
+	}
+}
@@ -1,9 +1,6 @@
 package goshopify
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
 
 const pagesBasePath = "admin/pages"
 const pagesResourceName = "pages"
@@ -14,10 +11,10 @@ const pagesResourceName = "pages"
 type PageService interface {
 	List(interface{}) ([]Page, error)
 	Count(interface{}) (int, error)
-	Get(int, interface{}) (*Page, error)
+	Get(int64, interface{}) (*Page, error)
 	Create(Page) (*Page, error)
 	Update(Page) (*Page, error)
-	Delete(int) error
+	Delete(int64) error
 
 	// MetafieldsService used for Pages resource to communicate with Metafields
 	// resource
@@ -32,106 +29,101 @@ type PageServiceOp struct {
 
 // Page represents a Shopify page.
 type Page struct {
-	ID             int         `json:"id"`
-	Author         string      `json:"author"`
-	Handle         string      `json:"handle"`
-	Title          string      `json:"title"`
-	CreatedAt      *time.Time  `json:"created_at"`
-	UpdatedAt      *time.Time  `json:"updated_at"`
-	BodyHTML       string      `json:"body_html"`
-	TemplateSuffix string      `json:"template_suffix"`
-	PublishedAt    *time.Time  `json:"published_at"`
-	ShopID         int         `json:"shop_id"`
-	Metafields     []Metafield `json:"metafields"`
+	ID                int64       `json:"id"`
+	Author            string      `json:"author"`
+	Handle            string      `json:"handle"`
+	Title             string      `json:"title"`
+	CreatedAt         *time.Time  `json:"created_at"`
+	UpdatedAt         *time.Time  `json:"updated_at"`
+	BodyHTML          string      `json:"body_html"`
+	TemplateSuffix    string      `json:"template_suffix"`
+	PublishedAt       *time.Time  `json:"published_at"`
+	ShopID            int64       `json:"shop_id"`
+	Metafields        []Metafield `json:"metafields"`
+	AdminGraphqlAPIID string      `json:"admin_graphql_api_id,omitempty"`
 }
 
 // PageResource represents the result from the pages/X.json endpoint
+//
+// Deprecated: PageService returns and takes a Page directly.
 type PageResource struct {
 	Page *Page `json:"page"`
 }
 
 // PagesResource represents the result from the pages.json endpoint
+//
+// Deprecated: PageService.List returns the pages directly.
 type PagesResource struct {
 	Pages []Page `json:"pages"`
 }
 
+// pages returns the resource for the pages endpoints
+func (s *PageServiceOp) pages() *Resource[Page] {
+	return NewResource[Page](s.client, pagesBasePath, "page", "pages")
+}
+
 // List pages
 func (s *PageServiceOp) List(options interface{}) ([]Page, error) {
-	path := fmt.Sprintf("%s.json", pagesBasePath)
-	resource := new(PagesResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Pages, err
+	return s.pages().List(options)
 }
 
 // Count pages
 func (s *PageServiceOp) Count(options interface{}) (int, error) {
-	path := fmt.Sprintf("%s/count.json", pagesBasePath)
-	return s.client.Count(path, options)
+	return s.pages().Count(options)
 }
 
 // Get individual page
-func (s *PageServiceOp) Get(pageID int, options interface{}) (*Page, error) {
-	path := fmt.Sprintf("%s/%d.json", pagesBasePath, pageID)
-	resource := new(PageResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Page, err
+func (s *PageServiceOp) Get(pageID int64, options interface{}) (*Page, error) {
+	return s.pages().Get(pageID, options)
 }
 
 // Create a new page
 func (s *PageServiceOp) Create(page Page) (*Page, error) {
-	path := fmt.Sprintf("%s.json", pagesBasePath)
-	wrappedData := PageResource{Page: &page}
-	resource := new(PageResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Page, err
+	return s.pages().Create(page)
 }
 
 // Update an existing page
 func (s *PageServiceOp) Update(page Page) (*Page, error) {
-	path := fmt.Sprintf("%s/%d.json", pagesBasePath, page.ID)
-	wrappedData := PageResource{Page: &page}
-	resource := new(PageResource)
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.Page, err
+	return s.pages().Update(page.ID, page)
 }
 
 // Delete an existing page.
-func (s *PageServiceOp) Delete(pageID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", pagesBasePath, pageID))
+func (s *PageServiceOp) Delete(pageID int64) error {
+	return s.pages().Delete(pageID)
 }
 
 // List metafields for a page
-func (s *PageServiceOp) ListMetafields(pageID int, options interface{}) ([]Metafield, error) {
+func (s *PageServiceOp) ListMetafields(pageID int64, options interface{}) ([]Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: pagesResourceName, resourceID: pageID}
 	return metafieldService.List(options)
 }
 
 // Count metafields for a page
-func (s *PageServiceOp) CountMetafields(pageID int, options interface{}) (int, error) {
+func (s *PageServiceOp) CountMetafields(pageID int64, options interface{}) (int, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: pagesResourceName, resourceID: pageID}
 	return metafieldService.Count(options)
 }
 
 // Get individual metafield for a page
-func (s *PageServiceOp) GetMetafield(pageID int, metafieldID int, options interface{}) (*Metafield, error) {
+func (s *PageServiceOp) GetMetafield(pageID int64, metafieldID int64, options interface{}) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: pagesResourceName, resourceID: pageID}
 	return metafieldService.Get(metafieldID, options)
 }
 
 // Create a new metafield for a page
-func (s *PageServiceOp) CreateMetafield(pageID int, metafield Metafield) (*Metafield, error) {
+func (s *PageServiceOp) CreateMetafield(pageID int64, metafield Metafield) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: pagesResourceName, resourceID: pageID}
 	return metafieldService.Create(metafield)
 }
 
 // Update an existing metafield for a page
-func (s *PageServiceOp) UpdateMetafield(pageID int, metafield Metafield) (*Metafield, error) {
+func (s *PageServiceOp) UpdateMetafield(pageID int64, metafield Metafield) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: pagesResourceName, resourceID: pageID}
 	return metafieldService.Update(metafield)
 }
 
 // Delete an existing metafield for a page
-func (s *PageServiceOp) DeleteMetafield(pageID int, metafieldID int) error {
+func (s *PageServiceOp) DeleteMetafield(pageID int64, metafieldID int64) error {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: pagesResourceName, resourceID: pageID}
 	return metafieldService.Delete(metafieldID)
 }
@@ -5,12 +5,12 @@ import (
 	"testing"
 	"time"
 
-	httpmock "gopkg.in/jarcoal/httpmock.v1"
+	httpmock "github.com/jarcoal/httpmock"
 )
 
 func pageTests(t *testing.T, page Page) {
 	// Check that ID is assigned to the returned page
-	expectedInt := 1
+	expectedInt := int64(1)
 	if page.ID != expectedInt {
 		t.Errorf("Page.ID returned %+v, expected %+v", page.ID, expectedInt)
 	}
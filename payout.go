@@ -0,0 +1,83 @@
+package goshopify
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// PayoutService is an interface for interfacing with the Shopify Payments
+// payouts endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/shopify_payments/payout
+type PayoutService interface {
+	List(interface{}) ([]Payout, error)
+	Get(int64, interface{}) (*Payout, error)
+}
+
+// PayoutServiceOp handles communication with the Shopify Payments payout
+// related methods of the Shopify API.
+type PayoutServiceOp struct {
+	client *Client
+}
+
+// Statuses of payouts
+const (
+	PayoutStatusScheduled = "scheduled"
+	PayoutStatusInTransit = "in_transit"
+	PayoutStatusPaid      = "paid"
+	PayoutStatusFailed    = "failed"
+	PayoutStatusCancelled = "cancelled"
+)
+
+// Payout represents a transfer of the Shopify Payments balance to the bank
+// account of the shop. Date is the date the payout was issued, e.g.
+// 2012-11-12.
+type Payout struct {
+	ID       int64            `json:"id,omitempty"`
+	Status   string           `json:"status,omitempty"`
+	Date     string           `json:"date,omitempty"`
+	Currency string           `json:"currency,omitempty"`
+	Amount   *decimal.Decimal `json:"amount,omitempty"`
+	Summary  *PayoutSummary   `json:"summary,omitempty"`
+}
+
+// PayoutSummary breaks the amount of a payout down by the types of the
+// balance transactions it pays out.
+type PayoutSummary struct {
+	AdjustmentsFeeAmount      *decimal.Decimal `json:"adjustments_fee_amount,omitempty"`
+	AdjustmentsGrossAmount    *decimal.Decimal `json:"adjustments_gross_amount,omitempty"`
+	ChargesFeeAmount          *decimal.Decimal `json:"charges_fee_amount,omitempty"`
+	ChargesGrossAmount        *decimal.Decimal `json:"charges_gross_amount,omitempty"`
+	RefundsFeeAmount          *decimal.Decimal `json:"refunds_fee_amount,omitempty"`
+	RefundsGrossAmount        *decimal.Decimal `json:"refunds_gross_amount,omitempty"`
+	ReservedFundsFeeAmount    *decimal.Decimal `json:"reserved_funds_fee_amount,omitempty"`
+	ReservedFundsGrossAmount  *decimal.Decimal `json:"reserved_funds_gross_amount,omitempty"`
+	RetriedPayoutsFeeAmount   *decimal.Decimal `json:"retried_payouts_fee_amount,omitempty"`
+	RetriedPayoutsGrossAmount *decimal.Decimal `json:"retried_payouts_gross_amount,omitempty"`
+}
+
+// PayoutListOptions filters the payouts to list by their status, one of the
+// PayoutStatus constants, and by their date, e.g. DateMin: "2012-11-01"
+type PayoutListOptions struct {
+	ShopifyPaymentsListOptions
+	Status  string `url:"status,omitempty"`
+	Date    string `url:"date,omitempty"`
+	DateMin string `url:"date_min,omitempty"`
+	DateMax string `url:"date_max,omitempty"`
+}
+
+// payouts returns the resource for the payouts endpoints
+func (s *PayoutServiceOp) payouts() *Resource[Payout] {
+	path := fmt.Sprintf("%s/payouts", shopifyPaymentsBasePath)
+	return NewResource[Payout](s.client, path, "payout", "payouts")
+}
+
+// List gets the payouts, most recent first.
+func (s *PayoutServiceOp) List(options interface{}) ([]Payout, error) {
+	return s.payouts().List(options)
+}
+
+// Get gets individual payout.
+func (s *PayoutServiceOp) Get(payoutID int64, options interface{}) (*Payout, error) {
+	return s.payouts().Get(payoutID, options)
+}
@@ -0,0 +1,69 @@
+package goshopify
+
+import (
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func payoutTests(t *testing.T, payout Payout) {
+	expectedID := int64(623721858)
+	if payout.ID != expectedID {
+		t.Errorf("Payout.ID returned %+v, expected %+v", payout.ID, expectedID)
+	}
+
+	if payout.Status != PayoutStatusPaid {
+		t.Errorf("Payout.Status returned %+v, expected %+v", payout.Status, PayoutStatusPaid)
+	}
+
+	expectedDate := "2012-11-12"
+	if payout.Date != expectedDate {
+		t.Errorf("Payout.Date returned %+v, expected %+v", payout.Date, expectedDate)
+	}
+
+	expectedAmount := decimal.RequireFromString("41.90")
+	if payout.Amount == nil || !payout.Amount.Equals(expectedAmount) {
+		t.Errorf("Payout.Amount returned %+v, expected %+v", payout.Amount, expectedAmount)
+	}
+
+	expectedChargesGross := decimal.RequireFromString("45.52")
+	if payout.Summary == nil || payout.Summary.ChargesGrossAmount == nil || !payout.Summary.ChargesGrossAmount.Equals(expectedChargesGross) {
+		t.Errorf("Payout.Summary returned %+v, expected charges gross amount %+v", payout.Summary, expectedChargesGross)
+	}
+}
+
+func TestPayoutList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/shopify_payments/payouts.json?date_max=2013-11-30&date_min=2012-11-01&status=paid",
+		httpmock.NewBytesResponder(200, loadFixture("payouts.json")))
+
+	options := PayoutListOptions{Status: PayoutStatusPaid, DateMin: "2012-11-01", DateMax: "2013-11-30"}
+	payouts, err := client.Payout.List(options)
+	if err != nil {
+		t.Fatalf("Payout.List returned error: %v", err)
+	}
+
+	if len(payouts) != 2 {
+		t.Fatalf("Payout.List returned %d payouts, expected 2", len(payouts))
+	}
+	payoutTests(t, payouts[0])
+}
+
+func TestPayoutGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/shopify_payments/payouts/623721858.json?fields=id%2Cstatus%2Cdate%2Camount%2Csummary",
+		httpmock.NewBytesResponder(200, loadFixture("payout.json")))
+
+	options := ListOptions{Fields: "id,status,date,amount,summary"}
+	payout, err := client.Payout.Get(623721858, options)
+	if err != nil {
+		t.Fatalf("Payout.Get returned error: %v", err)
+	}
+
+	payoutTests(t, *payout)
+}
@@ -0,0 +1,157 @@
+package goshopify
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const priceRulesBasePath = "admin/price_rules"
+
+// PriceRuleService is an interface for interfacing with the price rules
+// endpoints of the Shopify API.
+// See: https://help.shopify.com/api/reference/discounts/pricerule
+type PriceRuleService interface {
+	List(interface{}) ([]PriceRule, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*PriceRule, error)
+	Create(PriceRule) (*PriceRule, error)
+	Update(PriceRule) (*PriceRule, error)
+	Delete(int64) error
+}
+
+// PriceRuleServiceOp handles communication with the price rule related
+// methods of the Shopify API.
+type PriceRuleServiceOp struct {
+	client *Client
+}
+
+// Targets of a price rule
+const (
+	PriceRuleTargetTypeLineItem     = "line_item"
+	PriceRuleTargetTypeShippingLine = "shipping_line"
+)
+
+// Target selections of a price rule
+const (
+	PriceRuleTargetSelectionAll      = "all"
+	PriceRuleTargetSelectionEntitled = "entitled"
+)
+
+// Allocation methods of a price rule
+const (
+	PriceRuleAllocationMethodEach   = "each"
+	PriceRuleAllocationMethodAcross = "across"
+)
+
+// Value types of a price rule
+const (
+	PriceRuleValueTypeFixedAmount = "fixed_amount"
+	PriceRuleValueTypePercentage  = "percentage"
+)
+
+// Customer selections of a price rule
+const (
+	PriceRuleCustomerSelectionAll          = "all"
+	PriceRuleCustomerSelectionPrerequisite = "prerequisite"
+)
+
+// PriceRule represents a Shopify price rule, the logic of a discount. The
+// codes customers enter at checkout are the discount codes of the rule.
+// Value is negative, e.g. -10.0 for 10% or 10.00 off.
+//
+// The Entitled fields select the items the discount applies to when
+// TargetSelection is entitled, and the Prerequisite fields the conditions the
+// cart or customer must meet for the discount to apply.
+type PriceRule struct {
+	ID                                     int64                                            `json:"id,omitempty"`
+	Title                                  string                                           `json:"title,omitempty"`
+	TargetType                             string                                           `json:"target_type,omitempty"`
+	TargetSelection                        string                                           `json:"target_selection,omitempty"`
+	AllocationMethod                       string                                           `json:"allocation_method,omitempty"`
+	ValueType                              string                                           `json:"value_type,omitempty"`
+	Value                                  *decimal.Decimal                                 `json:"value,omitempty"`
+	OncePerCustomer                        bool                                             `json:"once_per_customer,omitempty"`
+	UsageLimit                             *int                                             `json:"usage_limit,omitempty"`
+	AllocationLimit                        *int                                             `json:"allocation_limit,omitempty"`
+	CustomerSelection                      string                                           `json:"customer_selection,omitempty"`
+	EntitledProductIDs                     []int64                                          `json:"entitled_product_ids,omitempty"`
+	EntitledVariantIDs                     []int64                                          `json:"entitled_variant_ids,omitempty"`
+	EntitledCollectionIDs                  []int64                                          `json:"entitled_collection_ids,omitempty"`
+	EntitledCountryIDs                     []int64                                          `json:"entitled_country_ids,omitempty"`
+	PrerequisiteProductIDs                 []int64                                          `json:"prerequisite_product_ids,omitempty"`
+	PrerequisiteVariantIDs                 []int64                                          `json:"prerequisite_variant_ids,omitempty"`
+	PrerequisiteCollectionIDs              []int64                                          `json:"prerequisite_collection_ids,omitempty"`
+	PrerequisiteCustomerIDs                []int64                                          `json:"prerequisite_customer_ids,omitempty"`
+	PrerequisiteSavedSearchIDs             []int64                                          `json:"prerequisite_saved_search_ids,omitempty"`
+	PrerequisiteSubtotalRange              *PriceRuleRange                                  `json:"prerequisite_subtotal_range,omitempty"`
+	PrerequisiteShippingPriceRange         *PriceRuleRange                                  `json:"prerequisite_shipping_price_range,omitempty"`
+	PrerequisiteQuantityRange              *PriceRuleQuantityRange                          `json:"prerequisite_quantity_range,omitempty"`
+	PrerequisiteToEntitlementQuantityRatio *PriceRulePrerequisiteToEntitlementQuantityRatio `json:"prerequisite_to_entitlement_quantity_ratio,omitempty"`
+	PrerequisiteToEntitlementPurchase      *PriceRulePrerequisiteToEntitlementPurchase      `json:"prerequisite_to_entitlement_purchase,omitempty"`
+	StartsAt                               *time.Time                                       `json:"starts_at,omitempty"`
+	EndsAt                                 *time.Time                                       `json:"ends_at,omitempty"`
+	CreatedAt                              *time.Time                                       `json:"created_at,omitempty"`
+	UpdatedAt                              *time.Time                                       `json:"updated_at,omitempty"`
+	AdminGraphqlAPIID                      string                                           `json:"admin_graphql_api_id,omitempty"`
+}
+
+// PriceRuleRange is a prerequisite range of amounts, e.g. a minimum subtotal
+// or a maximum shipping price
+type PriceRuleRange struct {
+	GreaterThanOrEqualTo *decimal.Decimal `json:"greater_than_or_equal_to,omitempty"`
+	LessThanOrEqualTo    *decimal.Decimal `json:"less_than_or_equal_to,omitempty"`
+}
+
+// PriceRuleQuantityRange is a prerequisite range of item quantities
+type PriceRuleQuantityRange struct {
+	GreaterThanOrEqualTo int `json:"greater_than_or_equal_to,omitempty"`
+}
+
+// PriceRulePrerequisiteToEntitlementQuantityRatio is the buy X get Y ratio of
+// a price rule, e.g. buy 2 get 1
+type PriceRulePrerequisiteToEntitlementQuantityRatio struct {
+	PrerequisiteQuantity int `json:"prerequisite_quantity,omitempty"`
+	EntitledQuantity     int `json:"entitled_quantity,omitempty"`
+}
+
+// PriceRulePrerequisiteToEntitlementPurchase is the amount of the
+// prerequisite items to buy for the entitled items to be discounted
+type PriceRulePrerequisiteToEntitlementPurchase struct {
+	PrerequisiteAmount *decimal.Decimal `json:"prerequisite_amount,omitempty"`
+}
+
+// priceRules returns the resource for the price rules endpoints
+func (s *PriceRuleServiceOp) priceRules() *Resource[PriceRule] {
+	return NewResource[PriceRule](s.client, priceRulesBasePath, "price_rule", "price_rules")
+}
+
+// List price rules
+func (s *PriceRuleServiceOp) List(options interface{}) ([]PriceRule, error) {
+	return s.priceRules().List(options)
+}
+
+// Count price rules
+func (s *PriceRuleServiceOp) Count(options interface{}) (int, error) {
+	return s.priceRules().Count(options)
+}
+
+// Get individual price rule
+func (s *PriceRuleServiceOp) Get(priceRuleID int64, options interface{}) (*PriceRule, error) {
+	return s.priceRules().Get(priceRuleID, options)
+}
+
+// Create a new price rule
+func (s *PriceRuleServiceOp) Create(priceRule PriceRule) (*PriceRule, error) {
+	return s.priceRules().Create(priceRule)
+}
+
+// Update an existing price rule
+func (s *PriceRuleServiceOp) Update(priceRule PriceRule) (*PriceRule, error) {
+	return s.priceRules().Update(priceRule.ID, priceRule)
+}
+
+// Delete an existing price rule
+func (s *PriceRuleServiceOp) Delete(priceRuleID int64) error {
+	return s.priceRules().Delete(priceRuleID)
+}
@@ -0,0 +1,188 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func priceRuleTests(t *testing.T, priceRule PriceRule) {
+	// Check that the ID is assigned to the returned price rule
+	expectedID := int64(507328175)
+	if priceRule.ID != expectedID {
+		t.Errorf("PriceRule.ID returned %+v, expected %+v", priceRule.ID, expectedID)
+	}
+
+	expectedValue := decimal.RequireFromString("-10.0")
+	if priceRule.Value == nil || !priceRule.Value.Equals(expectedValue) {
+		t.Errorf("PriceRule.Value returned %+v, expected %+v", priceRule.Value, expectedValue)
+	}
+
+	if priceRule.ValueType != PriceRuleValueTypePercentage {
+		t.Errorf("PriceRule.ValueType returned %+v, expected %+v", priceRule.ValueType, PriceRuleValueTypePercentage)
+	}
+
+	if priceRule.UsageLimit == nil || *priceRule.UsageLimit != 20 {
+		t.Errorf("PriceRule.UsageLimit returned %+v, expected %+v", priceRule.UsageLimit, 20)
+	}
+	if priceRule.AllocationLimit != nil {
+		t.Errorf("PriceRule.AllocationLimit returned %+v, expected nil", priceRule.AllocationLimit)
+	}
+
+	expectedCollectionIDs := []int64{841564295}
+	if !reflect.DeepEqual(priceRule.EntitledCollectionIDs, expectedCollectionIDs) {
+		t.Errorf("PriceRule.EntitledCollectionIDs returned %+v, expected %+v", priceRule.EntitledCollectionIDs, expectedCollectionIDs)
+	}
+
+	expectedSubtotal := decimal.RequireFromString("40.0")
+	subtotalRange := priceRule.PrerequisiteSubtotalRange
+	if subtotalRange == nil || subtotalRange.GreaterThanOrEqualTo == nil || !subtotalRange.GreaterThanOrEqualTo.Equals(expectedSubtotal) {
+		t.Errorf("PriceRule.PrerequisiteSubtotalRange returned %+v, expected at least %+v", subtotalRange, expectedSubtotal)
+	}
+
+	d := time.Date(2018, time.April, 1, 0, 0, 0, 0, time.UTC)
+	if priceRule.EndsAt == nil || !d.Equal(*priceRule.EndsAt) {
+		t.Errorf("PriceRule.EndsAt returned %+v, expected %+v", priceRule.EndsAt, d)
+	}
+}
+
+func TestPriceRuleList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules.json",
+		httpmock.NewBytesResponder(200, loadFixture("price_rules.json")))
+
+	priceRules, err := client.PriceRule.List(nil)
+	if err != nil {
+		t.Errorf("PriceRule.List returned error: %v", err)
+	}
+
+	if len(priceRules) != 2 {
+		t.Fatalf("PriceRule.List returned %d price rules, expected 2", len(priceRules))
+	}
+	priceRuleTests(t, priceRules[0])
+}
+
+func TestPriceRuleCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/count.json",
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.PriceRule.Count(nil)
+	if err != nil {
+		t.Errorf("PriceRule.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("PriceRule.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestPriceRuleGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/price_rules/507328175.json",
+		httpmock.NewBytesResponder(200, loadFixture("price_rule.json")))
+
+	priceRule, err := client.PriceRule.Get(507328175, nil)
+	if err != nil {
+		t.Errorf("PriceRule.Get returned error: %v", err)
+	}
+
+	priceRuleTests(t, *priceRule)
+}
+
+func TestPriceRuleCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/price_rules.json",
+		bodyCheckingResponder(t,
+			`{"price_rule": {
+				"title": "SUMMERSALE10OFF",
+				"target_type": "line_item",
+				"target_selection": "entitled",
+				"allocation_method": "across",
+				"value_type": "percentage",
+				"value": "-10",
+				"usage_limit": 20,
+				"customer_selection": "all",
+				"entitled_collection_ids": [841564295],
+				"prerequisite_subtotal_range": {"greater_than_or_equal_to": "40"},
+				"starts_at": "2018-03-22T00:00:00Z"
+			}}`,
+			201,
+			loadFixture("price_rule.json")))
+
+	usageLimit := 20
+	startsAt := time.Date(2018, time.March, 22, 0, 0, 0, 0, time.UTC)
+	priceRule := PriceRule{
+		Title:                 "SUMMERSALE10OFF",
+		TargetType:            PriceRuleTargetTypeLineItem,
+		TargetSelection:       PriceRuleTargetSelectionEntitled,
+		AllocationMethod:      PriceRuleAllocationMethodAcross,
+		ValueType:             PriceRuleValueTypePercentage,
+		Value:                 decimalPtr("-10"),
+		UsageLimit:            &usageLimit,
+		CustomerSelection:     PriceRuleCustomerSelectionAll,
+		EntitledCollectionIDs: []int64{841564295},
+		PrerequisiteSubtotalRange: &PriceRuleRange{
+			GreaterThanOrEqualTo: decimalPtr("40"),
+		},
+		StartsAt: &startsAt,
+	}
+
+	created, err := client.PriceRule.Create(priceRule)
+	if err != nil {
+		t.Errorf("PriceRule.Create returned error: %v", err)
+	}
+
+	priceRuleTests(t, *created)
+}
+
+func TestPriceRuleUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/price_rules/507328175.json",
+		bodyCheckingResponder(t,
+			`{"price_rule": {"id": 507328175, "title": "WINTERSALE20OFF", "value": "-20"}}`,
+			200,
+			[]byte(`{"price_rule": {"id": 507328175, "title": "WINTERSALE20OFF", "value": "-20"}}`)))
+
+	priceRule := PriceRule{
+		ID:    507328175,
+		Title: "WINTERSALE20OFF",
+		Value: decimalPtr("-20"),
+	}
+
+	updated, err := client.PriceRule.Update(priceRule)
+	if err != nil {
+		t.Errorf("PriceRule.Update returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(updated, &priceRule) {
+		t.Errorf("PriceRule.Update returned %+v, expected %+v", updated, priceRule)
+	}
+}
+
+func TestPriceRuleDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/price_rules/507328175.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.PriceRule.Delete(507328175)
+	if err != nil {
+		t.Errorf("PriceRule.Delete returned error: %v", err)
+	}
+}
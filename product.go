@@ -14,13 +14,17 @@ const productsResourceName = "products"
 type ProductService interface {
 	List(interface{}) ([]Product, error)
 	Count(interface{}) (int, error)
-	Get(int, interface{}) (*Product, error)
+	Get(int64, interface{}) (*Product, error)
 	Create(Product) (*Product, error)
 	Update(Product) (*Product, error)
-	Delete(int) error
+	Delete(int64) error
+	ListByCollection(int64, interface{}) ([]Product, error)
 
 	// MetafieldsService used for Product resource to communicate with Metafields resource
 	MetafieldsService
+
+	// EventsService used for Product resource to communicate with Events resource
+	EventsService
 }
 
 // ProductServiceOp handles communication with the product related methods of
@@ -31,7 +35,7 @@ type ProductServiceOp struct {
 
 // Product represents a Shopify product
 type Product struct {
-	ID                             int             `json:"id,omitempty"`
+	ID                             int64           `json:"id,omitempty"`
 	Title                          string          `json:"title,omitempty"`
 	BodyHTML                       string          `json:"body_html,omitempty"`
 	Vendor                         string          `json:"vendor,omitempty"`
@@ -55,100 +59,107 @@ type Product struct {
 
 // The options provided by Shopify
 type ProductOption struct {
-	ID        int      `json:"id,omitempty"`
-	ProductID int      `json:"product_id,omitempty"`
+	ID        int64    `json:"id,omitempty"`
+	ProductID int64    `json:"product_id,omitempty"`
 	Name      string   `json:"name,omitempty"`
 	Position  int      `json:"position,omitempty"`
 	Values    []string `json:"values,omitempty"`
 }
 
 // Represents the result from the products/X.json endpoint
+//
+// Deprecated: ProductService returns and takes a Product directly.
 type ProductResource struct {
 	Product *Product `json:"product"`
 }
 
 // Represents the result from the products.json endpoint
+//
+// Deprecated: ProductService.List returns the products directly.
 type ProductsResource struct {
 	Products []Product `json:"products"`
 }
 
+// products returns the resource for the products endpoints
+func (s *ProductServiceOp) products() *Resource[Product] {
+	return NewResource[Product](s.client, productsBasePath, "product", "products")
+}
+
 // List products
 func (s *ProductServiceOp) List(options interface{}) ([]Product, error) {
-	path := fmt.Sprintf("%s.json", productsBasePath)
-	resource := new(ProductsResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Products, err
+	return s.products().List(options)
 }
 
 // Count products
 func (s *ProductServiceOp) Count(options interface{}) (int, error) {
-	path := fmt.Sprintf("%s/count.json", productsBasePath)
-	return s.client.Count(path, options)
+	return s.products().Count(options)
 }
 
 // Get individual product
-func (s *ProductServiceOp) Get(productID int, options interface{}) (*Product, error) {
-	path := fmt.Sprintf("%s/%d.json", productsBasePath, productID)
-	resource := new(ProductResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Product, err
+func (s *ProductServiceOp) Get(productID int64, options interface{}) (*Product, error) {
+	return s.products().Get(productID, options)
 }
 
 // Create a new product
 func (s *ProductServiceOp) Create(product Product) (*Product, error) {
-	path := fmt.Sprintf("%s.json", productsBasePath)
-	wrappedData := ProductResource{Product: &product}
-	resource := new(ProductResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Product, err
+	return s.products().Create(product)
 }
 
 // Update an existing product
 func (s *ProductServiceOp) Update(product Product) (*Product, error) {
-	path := fmt.Sprintf("%s/%d.json", productsBasePath, product.ID)
-	wrappedData := ProductResource{Product: &product}
-	resource := new(ProductResource)
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.Product, err
+	return s.products().Update(product.ID, product)
 }
 
 // Delete an existing product
-func (s *ProductServiceOp) Delete(productID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", productsBasePath, productID))
+func (s *ProductServiceOp) Delete(productID int64) error {
+	return s.products().Delete(productID)
+}
+
+// ListByCollection lists the products of a smart or custom collection, in
+// the sort order of the collection
+func (s *ProductServiceOp) ListByCollection(collectionID int64, options interface{}) ([]Product, error) {
+	path := fmt.Sprintf("%s/%d/products", collectionsBasePath, collectionID)
+	return NewResource[Product](s.client, path, "product", "products").List(options)
 }
 
 // List metafields for a product
-func (s *ProductServiceOp) ListMetafields(productID int, options interface{}) ([]Metafield, error) {
+func (s *ProductServiceOp) ListMetafields(productID int64, options interface{}) ([]Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: productsResourceName, resourceID: productID}
 	return metafieldService.List(options)
 }
 
 // Count metafields for a product
-func (s *ProductServiceOp) CountMetafields(productID int, options interface{}) (int, error) {
+func (s *ProductServiceOp) CountMetafields(productID int64, options interface{}) (int, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: productsResourceName, resourceID: productID}
 	return metafieldService.Count(options)
 }
 
 // Get individual metafield for a product
-func (s *ProductServiceOp) GetMetafield(productID int, metafieldID int, options interface{}) (*Metafield, error) {
+func (s *ProductServiceOp) GetMetafield(productID int64, metafieldID int64, options interface{}) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: productsResourceName, resourceID: productID}
 	return metafieldService.Get(metafieldID, options)
 }
 
 // Create a new metafield for a product
-func (s *ProductServiceOp) CreateMetafield(productID int, metafield Metafield) (*Metafield, error) {
+func (s *ProductServiceOp) CreateMetafield(productID int64, metafield Metafield) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: productsResourceName, resourceID: productID}
 	return metafieldService.Create(metafield)
 }
 
 // Update an existing metafield for a product
-func (s *ProductServiceOp) UpdateMetafield(productID int, metafield Metafield) (*Metafield, error) {
+func (s *ProductServiceOp) UpdateMetafield(productID int64, metafield Metafield) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: productsResourceName, resourceID: productID}
 	return metafieldService.Update(metafield)
 }
 
 // // Delete an existing metafield for a product
-func (s *ProductServiceOp) DeleteMetafield(productID int, metafieldID int) error {
+func (s *ProductServiceOp) DeleteMetafield(productID int64, metafieldID int64) error {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: productsResourceName, resourceID: productID}
 	return metafieldService.Delete(metafieldID)
 }
+
+// List events for a product
+func (s *ProductServiceOp) ListEvents(productID int64, options interface{}) ([]Event, error) {
+	eventService := &EventServiceOp{client: s.client, resource: productsResourceName, resourceID: productID}
+	return eventService.List(options)
+}
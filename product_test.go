@@ -5,12 +5,12 @@ import (
 	"testing"
 	"time"
 
-	"gopkg.in/jarcoal/httpmock.v1"
+	"github.com/jarcoal/httpmock"
 )
 
 func productTests(t *testing.T, product Product) {
 	// Check that ID is assigned to the returned product
-	expectedInt := 1071559748
+	expectedInt := int64(1071559748)
 	if product.ID != expectedInt {
 		t.Errorf("Product.ID returned %+v, expected %+v", product.ID, expectedInt)
 	}
@@ -41,7 +41,7 @@ func TestProductListFilterByIds(t *testing.T) {
 	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/products.json?ids=1%2C2%2C3",
 		httpmock.NewStringResponder(200, `{"products": [{"id":1},{"id":2},{"id":3}]}`))
 
-	listOptions := ListOptions{IDs: []int{1, 2, 3}}
+	listOptions := ListOptions{IDs: []int64{1, 2, 3}}
 
 	products, err := client.Product.List(listOptions)
 	if err != nil {
@@ -177,6 +177,24 @@ func TestProductListMetafields(t *testing.T) {
 	}
 }
 
+func TestProductListEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/products/632910392/events.json",
+		httpmock.NewBytesResponder(200, loadFixture("events.json")))
+
+	events, err := client.Product.ListEvents(632910392, nil)
+	if err != nil {
+		t.Errorf("Product.ListEvents() returned error: %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("Product.ListEvents() returned %d events, expected 2", len(events))
+	}
+	eventTests(t, events[0])
+}
+
 func TestProductCountMetafields(t *testing.T) {
 	setup()
 	defer teardown()
@@ -284,3 +302,21 @@ func TestProductDeleteMetafield(t *testing.T) {
 		t.Errorf("Product.DeleteMetafield() returned error: %v", err)
 	}
 }
+
+func TestProductListByCollection(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/collections/841564295/products.json?limit=2",
+		httpmock.NewStringResponder(200, `{"products": [{"id":632910392},{"id":921728736}]}`))
+
+	products, err := client.Product.ListByCollection(841564295, ListOptions{Limit: 2})
+	if err != nil {
+		t.Errorf("Product.ListByCollection returned error: %v", err)
+	}
+
+	expected := []Product{{ID: 632910392}, {ID: 921728736}}
+	if !reflect.DeepEqual(products, expected) {
+		t.Errorf("Product.ListByCollection returned %+v, expected %+v", products, expected)
+	}
+}
@@ -0,0 +1,96 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const productListingsBasePath = "admin/product_listings"
+
+// ProductListingService is an interface for interfacing with the product
+// listings endpoints of the Shopify API. Product listings are the products
+// published to the sales channel of the app.
+// See: https://help.shopify.com/api/reference/sales-channels/productlisting
+type ProductListingService interface {
+	List(interface{}) ([]ProductListing, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*ProductListing, error)
+	ListProductIDs(interface{}) ([]int64, error)
+	Publish(int64) (*ProductListing, error)
+	Unpublish(int64) error
+}
+
+// ProductListingServiceOp handles communication with the product listing
+// related methods of the Shopify API.
+type ProductListingServiceOp struct {
+	client *Client
+}
+
+// ProductListing represents a product published to a sales channel. It
+// carries the product fields the channel may display.
+type ProductListing struct {
+	ProductID   int64           `json:"product_id,omitempty"`
+	Title       string          `json:"title,omitempty"`
+	BodyHTML    string          `json:"body_html,omitempty"`
+	Vendor      string          `json:"vendor,omitempty"`
+	ProductType string          `json:"product_type,omitempty"`
+	Handle      string          `json:"handle,omitempty"`
+	Tags        string          `json:"tags,omitempty"`
+	Available   bool            `json:"available,omitempty"`
+	Options     []ProductOption `json:"options,omitempty"`
+	Variants    []Variant       `json:"variants,omitempty"`
+	Images      []Image         `json:"images,omitempty"`
+	PublishedAt *time.Time      `json:"published_at,omitempty"`
+	CreatedAt   *time.Time      `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time      `json:"updated_at,omitempty"`
+}
+
+// ProductListingListOptions filters the product listings to list
+type ProductListingListOptions struct {
+	ListOptions
+	ProductIDs   []int64 `url:"product_ids,omitempty,comma"`
+	CollectionID int64   `url:"collection_id,omitempty"`
+	Handle       string  `url:"handle,omitempty"`
+}
+
+// productListings returns the resource for the product listings endpoints
+func (s *ProductListingServiceOp) productListings() *Resource[ProductListing] {
+	return NewResource[ProductListing](s.client, productListingsBasePath, "product_listing", "product_listings")
+}
+
+// List product listings
+func (s *ProductListingServiceOp) List(options interface{}) ([]ProductListing, error) {
+	return s.productListings().List(options)
+}
+
+// Count product listings
+func (s *ProductListingServiceOp) Count(options interface{}) (int, error) {
+	return s.productListings().Count(options)
+}
+
+// Get the listing of a product
+func (s *ProductListingServiceOp) Get(productID int64, options interface{}) (*ProductListing, error) {
+	return s.productListings().Get(productID, options)
+}
+
+// ListProductIDs lists the IDs of the published products, which is cheaper
+// than listing the product listings when syncing the channel
+func (s *ProductListingServiceOp) ListProductIDs(options interface{}) ([]int64, error) {
+	path := fmt.Sprintf("%s/product_ids.json", productListingsBasePath)
+	resource := new(struct {
+		ProductIDs []int64 `json:"product_ids"`
+	})
+	err := s.client.Get(path, resource, options)
+	return resource.ProductIDs, err
+}
+
+// Publish a product to the sales channel
+func (s *ProductListingServiceOp) Publish(productID int64) (*ProductListing, error) {
+	data := map[string]ProductListing{"product_listing": {ProductID: productID}}
+	return s.productListings().Do("PUT", fmt.Sprintf("%d", productID), data, nil)
+}
+
+// Unpublish a product from the sales channel
+func (s *ProductListingServiceOp) Unpublish(productID int64) error {
+	return s.productListings().Delete(productID)
+}
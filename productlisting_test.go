@@ -0,0 +1,138 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func productListingTests(t *testing.T, productListing ProductListing) {
+	// Check that the product ID is assigned to the returned product listing
+	expectedID := int64(921728736)
+	if productListing.ProductID != expectedID {
+		t.Errorf("ProductListing.ProductID returned %+v, expected %+v", productListing.ProductID, expectedID)
+	}
+
+	if !productListing.Available {
+		t.Errorf("ProductListing.Available returned %+v, expected %+v", productListing.Available, true)
+	}
+
+	if len(productListing.Variants) != 1 {
+		t.Fatalf("ProductListing.Variants returned %d variants, expected 1", len(productListing.Variants))
+	}
+	expectedPrice := decimal.RequireFromString("199.00")
+	variant := productListing.Variants[0]
+	if variant.Sku != "IPOD2009BLACK" || variant.Price == nil || !variant.Price.Equals(expectedPrice) {
+		t.Errorf("ProductListing.Variants returned %+v, expected sku IPOD2009BLACK at %s", variant, expectedPrice)
+	}
+
+	if len(productListing.Images) != 1 || productListing.Images[0].ID != 850703190 {
+		t.Errorf("ProductListing.Images returned %+v, expected image %d", productListing.Images, 850703190)
+	}
+
+	if len(productListing.Options) != 1 || productListing.Options[0].Name != "Title" {
+		t.Errorf("ProductListing.Options returned %+v, expected a Title option", productListing.Options)
+	}
+}
+
+func TestProductListingList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/product_listings.json?product_ids=921728736%2C632910392",
+		httpmock.NewBytesResponder(200, loadFixture("product_listings.json")))
+
+	productListings, err := client.ProductListing.List(ProductListingListOptions{ProductIDs: []int64{921728736, 632910392}})
+	if err != nil {
+		t.Errorf("ProductListing.List returned error: %v", err)
+	}
+
+	if len(productListings) != 2 {
+		t.Fatalf("ProductListing.List returned %d product listings, expected 2", len(productListings))
+	}
+	productListingTests(t, productListings[0])
+}
+
+func TestProductListingCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/product_listings/count.json",
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.ProductListing.Count(nil)
+	if err != nil {
+		t.Errorf("ProductListing.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("ProductListing.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestProductListingGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/product_listings/921728736.json",
+		httpmock.NewBytesResponder(200, loadFixture("product_listing.json")))
+
+	productListing, err := client.ProductListing.Get(921728736, nil)
+	if err != nil {
+		t.Errorf("ProductListing.Get returned error: %v", err)
+	}
+
+	productListingTests(t, *productListing)
+}
+
+func TestProductListingListProductIDs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/product_listings/product_ids.json?limit=2",
+		httpmock.NewStringResponder(200, `{"product_ids": [921728736, 632910392]}`))
+
+	productIDs, err := client.ProductListing.ListProductIDs(ListOptions{Limit: 2})
+	if err != nil {
+		t.Errorf("ProductListing.ListProductIDs returned error: %v", err)
+	}
+
+	expected := []int64{921728736, 632910392}
+	if !reflect.DeepEqual(productIDs, expected) {
+		t.Errorf("ProductListing.ListProductIDs returned %+v, expected %+v", productIDs, expected)
+	}
+}
+
+func TestProductListingPublish(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/product_listings/921728736.json",
+		bodyCheckingResponder(t,
+			`{"product_listing": {"product_id": 921728736}}`,
+			200,
+			loadFixture("product_listing.json")))
+
+	productListing, err := client.ProductListing.Publish(921728736)
+	if err != nil {
+		t.Errorf("ProductListing.Publish returned error: %v", err)
+	}
+
+	productListingTests(t, *productListing)
+}
+
+func TestProductListingUnpublish(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/product_listings/921728736.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.ProductListing.Unpublish(921728736)
+	if err != nil {
+		t.Errorf("ProductListing.Unpublish returned error: %v", err)
+	}
+}
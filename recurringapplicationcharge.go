@@ -15,11 +15,11 @@ const recurringApplicationChargesBasePath = "admin/recurring_application_charges
 // See https://help.shopify.com/api/reference/billing/recurringapplicationcharge
 type RecurringApplicationChargeService interface {
 	Create(RecurringApplicationCharge) (*RecurringApplicationCharge, error)
-	Get(int, interface{}) (*RecurringApplicationCharge, error)
+	Get(int64, interface{}) (*RecurringApplicationCharge, error)
 	List(interface{}) ([]RecurringApplicationCharge, error)
 	Activate(RecurringApplicationCharge) (*RecurringApplicationCharge, error)
-	Delete(int) error
-	Update(int, int) (*RecurringApplicationCharge, error)
+	Delete(int64) error
+	Update(int64, int) (*RecurringApplicationCharge, error)
 }
 
 // RecurringApplicationChargeServiceOp handles communication with the
@@ -28,9 +28,20 @@ type RecurringApplicationChargeServiceOp struct {
 	client *Client
 }
 
+// Statuses of a recurring application charge
+const (
+	RecurringApplicationChargeStatusPending   = "pending"
+	RecurringApplicationChargeStatusAccepted  = "accepted"
+	RecurringApplicationChargeStatusActive    = "active"
+	RecurringApplicationChargeStatusDeclined  = "declined"
+	RecurringApplicationChargeStatusExpired   = "expired"
+	RecurringApplicationChargeStatusFrozen    = "frozen"
+	RecurringApplicationChargeStatusCancelled = "cancelled"
+)
+
 // RecurringApplicationCharge represents a Shopify RecurringApplicationCharge.
 type RecurringApplicationCharge struct {
-	APIClientID           int              `json:"api_client_id"`
+	APIClientID           int64            `json:"api_client_id"`
 	ActivatedOn           *time.Time       `json:"activated_on"`
 	BalanceRemaining      *decimal.Decimal `json:"balance_remaining"`
 	BalanceUsed           *decimal.Decimal `json:"balance_used"`
@@ -40,7 +51,7 @@ type RecurringApplicationCharge struct {
 	ConfirmationURL       string           `json:"confirmation_url"`
 	CreatedAt             *time.Time       `json:"created_at"`
 	DecoratedReturnURL    string           `json:"decorated_return_url"`
-	ID                    int              `json:"id"`
+	ID                    int64            `json:"id"`
 	Name                  string           `json:"name"`
 	Price                 *decimal.Decimal `json:"price"`
 	ReturnURL             string           `json:"return_url"`
@@ -52,6 +63,7 @@ type RecurringApplicationCharge struct {
 	TrialEndsOn           *time.Time       `json:"trial_ends_on"`
 	UpdateCappedAmountURL string           `json:"update_capped_amount_url"`
 	UpdatedAt             *time.Time       `json:"updated_at"`
+	AdminGraphqlAPIID     string           `json:"admin_graphql_api_id,omitempty"`
 }
 
 func parse(dest **time.Time, data *string) error {
@@ -136,7 +148,7 @@ func (r *RecurringApplicationChargeServiceOp) Create(charge RecurringApplication
 }
 
 // Get gets individual recurring application charge.
-func (r *RecurringApplicationChargeServiceOp) Get(chargeID int, options interface{}) (
+func (r *RecurringApplicationChargeServiceOp) Get(chargeID int64, options interface{}) (
 	*RecurringApplicationCharge, error) {
 
 	path := fmt.Sprintf("%s/%d.json", recurringApplicationChargesBasePath, chargeID)
@@ -167,12 +179,12 @@ func (r *RecurringApplicationChargeServiceOp) Activate(charge RecurringApplicati
 }
 
 // Delete deletes recurring application charge.
-func (r *RecurringApplicationChargeServiceOp) Delete(chargeID int) error {
+func (r *RecurringApplicationChargeServiceOp) Delete(chargeID int64) error {
 	return r.client.Delete(fmt.Sprintf("%s/%d.json", recurringApplicationChargesBasePath, chargeID))
 }
 
 // Update updates recurring application charge.
-func (r *RecurringApplicationChargeServiceOp) Update(chargeID, newCappedAmount int) (
+func (r *RecurringApplicationChargeServiceOp) Update(chargeID int64, newCappedAmount int) (
 	*RecurringApplicationCharge, error) {
 
 	path := fmt.Sprintf("%s/%d/customize.json?recurring_application_charge[capped_amount]=%d",
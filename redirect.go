@@ -1,9 +1,5 @@
 package goshopify
 
-import (
-	"fmt"
-)
-
 const redirectsBasePath = "admin/redirects"
 
 // RedirectService is an interface for interacting with the redirects
@@ -12,10 +8,10 @@ const redirectsBasePath = "admin/redirects"
 type RedirectService interface {
 	List(interface{}) ([]Redirect, error)
 	Count(interface{}) (int, error)
-	Get(int, interface{}) (*Redirect, error)
+	Get(int64, interface{}) (*Redirect, error)
 	Create(Redirect) (*Redirect, error)
 	Update(Redirect) (*Redirect, error)
-	Delete(int) error
+	Delete(int64) error
 }
 
 // RedirectServiceOp handles communication with the redirect related methods of the
@@ -26,62 +22,57 @@ type RedirectServiceOp struct {
 
 // Redirect represents a Shopify redirect.
 type Redirect struct {
-	ID     int    `json:"id"`
-	Path   string `json:"path"`
-	Target string `json:"target"`
+	ID                int64  `json:"id"`
+	Path              string `json:"path"`
+	Target            string `json:"target"`
+	AdminGraphqlAPIID string `json:"admin_graphql_api_id,omitempty"`
 }
 
 // RedirectResource represents the result from the redirects/X.json endpoint
+//
+// Deprecated: RedirectService returns and takes a Redirect directly.
 type RedirectResource struct {
 	Redirect *Redirect `json:"redirect"`
 }
 
 // RedirectsResource represents the result from the redirects.json endpoint
+//
+// Deprecated: RedirectService.List returns the redirects directly.
 type RedirectsResource struct {
 	Redirects []Redirect `json:"redirects"`
 }
 
+// redirects returns the resource for the redirects endpoints
+func (s *RedirectServiceOp) redirects() *Resource[Redirect] {
+	return NewResource[Redirect](s.client, redirectsBasePath, "redirect", "redirects")
+}
+
 // List redirects
 func (s *RedirectServiceOp) List(options interface{}) ([]Redirect, error) {
-	path := fmt.Sprintf("%s.json", redirectsBasePath)
-	resource := new(RedirectsResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Redirects, err
+	return s.redirects().List(options)
 }
 
 // Count redirects
 func (s *RedirectServiceOp) Count(options interface{}) (int, error) {
-	path := fmt.Sprintf("%s/count.json", redirectsBasePath)
-	return s.client.Count(path, options)
+	return s.redirects().Count(options)
 }
 
 // Get individual redirect
-func (s *RedirectServiceOp) Get(redirectID int, options interface{}) (*Redirect, error) {
-	path := fmt.Sprintf("%s/%d.json", redirectsBasePath, redirectID)
-	resource := new(RedirectResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Redirect, err
+func (s *RedirectServiceOp) Get(redirectID int64, options interface{}) (*Redirect, error) {
+	return s.redirects().Get(redirectID, options)
 }
 
 // Create a new redirect
 func (s *RedirectServiceOp) Create(redirect Redirect) (*Redirect, error) {
-	path := fmt.Sprintf("%s.json", redirectsBasePath)
-	wrappedData := RedirectResource{Redirect: &redirect}
-	resource := new(RedirectResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Redirect, err
+	return s.redirects().Create(redirect)
 }
 
 // Update an existing redirect
 func (s *RedirectServiceOp) Update(redirect Redirect) (*Redirect, error) {
-	path := fmt.Sprintf("%s/%d.json", redirectsBasePath, redirect.ID)
-	wrappedData := RedirectResource{Redirect: &redirect}
-	resource := new(RedirectResource)
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.Redirect, err
+	return s.redirects().Update(redirect.ID, redirect)
 }
 
 // Delete an existing redirect.
-func (s *RedirectServiceOp) Delete(redirectID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", redirectsBasePath, redirectID))
+func (s *RedirectServiceOp) Delete(redirectID int64) error {
+	return s.redirects().Delete(redirectID)
 }
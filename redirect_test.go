@@ -5,12 +5,12 @@ import (
 	"testing"
 	"time"
 
-	httpmock "gopkg.in/jarcoal/httpmock.v1"
+	httpmock "github.com/jarcoal/httpmock"
 )
 
 func redirectTests(t *testing.T, redirect Redirect) {
 	// Check that ID is assigned to the returned redirect
-	expectedInt := 1
+	expectedInt := int64(1)
 	if redirect.ID != expectedInt {
 		t.Errorf("Redirect.ID returned %+v, expected %+v", redirect.ID, expectedInt)
 	}
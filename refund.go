@@ -0,0 +1,105 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// RefundService is an interface for interfacing with the refunds endpoints of
+// the Shopify API.
+// See: https://help.shopify.com/api/reference/refund
+type RefundService interface {
+	List(int64, interface{}) ([]Refund, error)
+	Get(int64, int64, interface{}) (*Refund, error)
+	Calculate(int64, Refund) (*Refund, error)
+	Create(int64, Refund) (*Refund, error)
+}
+
+// RefundServiceOp handles communication with the refund related methods of
+// the Shopify API.
+type RefundServiceOp struct {
+	client *Client
+}
+
+// Restock types of a refund line item
+const (
+	RefundRestockTypeNoRestock     = "no_restock"
+	RefundRestockTypeCancel        = "cancel"
+	RefundRestockTypeReturn        = "return"
+	RefundRestockTypeLegacyRestock = "legacy_restock"
+)
+
+// Transaction kind of the transactions suggested by Calculate
+const TransactionKindSuggestedRefund = "suggested_refund"
+
+// Refund represents a Shopify refund of an order
+type Refund struct {
+	Id                int64            `json:"id,omitempty"`
+	OrderId           int64            `json:"order_id,omitempty"`
+	CreatedAt         *time.Time       `json:"created_at,omitempty"`
+	ProcessedAt       *time.Time       `json:"processed_at,omitempty"`
+	Note              string           `json:"note,omitempty"`
+	Restock           bool             `json:"restock,omitempty"`
+	Notify            bool             `json:"notify,omitempty"`
+	Currency          string           `json:"currency,omitempty"`
+	UserId            int64            `json:"user_id,omitempty"`
+	Shipping          *RefundShipping  `json:"shipping,omitempty"`
+	RefundLineItems   []RefundLineItem `json:"refund_line_items,omitempty"`
+	Transactions      []Transaction    `json:"transactions,omitempty"`
+	AdminGraphqlAPIID string           `json:"admin_graphql_api_id,omitempty"`
+}
+
+// RefundShipping is the shipping refunded by a refund. Set either FullRefund
+// or Amount when creating a refund.
+type RefundShipping struct {
+	FullRefund        bool             `json:"full_refund,omitempty"`
+	Amount            *decimal.Decimal `json:"amount,omitempty"`
+	Tax               *decimal.Decimal `json:"tax,omitempty"`
+	MaximumRefundable *decimal.Decimal `json:"maximum_refundable,omitempty"`
+}
+
+// RefundLineItem is a line item of a refund. RestockType is one of the
+// RefundRestockType constants, and LocationId the location the item is
+// restocked at.
+type RefundLineItem struct {
+	Id          int64            `json:"id,omitempty"`
+	Quantity    int              `json:"quantity,omitempty"`
+	LineItemId  int64            `json:"line_item_id,omitempty"`
+	LineItem    *LineItem        `json:"line_item,omitempty"`
+	RestockType string           `json:"restock_type,omitempty"`
+	LocationId  int64            `json:"location_id,omitempty"`
+	Price       *decimal.Decimal `json:"price,omitempty"`
+	Subtotal    *decimal.Decimal `json:"subtotal,omitempty"`
+	TotalTax    *decimal.Decimal `json:"total_tax,omitempty"`
+}
+
+// refunds returns the resource for the refunds endpoints of an order
+func (s *RefundServiceOp) refunds(orderID int64) *Resource[Refund] {
+	path := fmt.Sprintf("%s/%d/refunds", ordersBasePath, orderID)
+	return NewResource[Refund](s.client, path, "refund", "refunds")
+}
+
+// List refunds
+func (s *RefundServiceOp) List(orderID int64, options interface{}) ([]Refund, error) {
+	return s.refunds(orderID).List(options)
+}
+
+// Get individual refund
+func (s *RefundServiceOp) Get(orderID int64, refundID int64, options interface{}) (*Refund, error) {
+	return s.refunds(orderID).Get(refundID, options)
+}
+
+// Calculate a refund. The returned refund contains the refundable shipping,
+// the line items with their prices, and the transactions suggested for
+// creating the refund, which have the TransactionKindSuggestedRefund kind.
+// Change their kind to "refund" before passing them to Create.
+func (s *RefundServiceOp) Calculate(orderID int64, refund Refund) (*Refund, error) {
+	return s.refunds(orderID).CollectionAction("calculate", map[string]Refund{"refund": refund})
+}
+
+// Create a new refund
+func (s *RefundServiceOp) Create(orderID int64, refund Refund) (*Refund, error) {
+	return s.refunds(orderID).Create(refund)
+}
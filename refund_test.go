@@ -0,0 +1,162 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func refundTests(t *testing.T, refund Refund) {
+	// Check that the ID is assigned to the returned refund
+	expectedID := int64(509562969)
+	if refund.Id != expectedID {
+		t.Errorf("Refund.Id returned %+v, expected %+v", refund.Id, expectedID)
+	}
+
+	// Check that the OrderId value is assigned to the returned refund
+	expectedOrderID := int64(450789469)
+	if refund.OrderId != expectedOrderID {
+		t.Errorf("Refund.OrderId returned %+v, expected %+v", refund.OrderId, expectedOrderID)
+	}
+
+	// Check the restock type and location of the refund line items
+	if len(refund.RefundLineItems) != 1 {
+		t.Fatalf("Refund.RefundLineItems returned %d items, expected 1", len(refund.RefundLineItems))
+	}
+	lineItem := refund.RefundLineItems[0]
+	if lineItem.RestockType != RefundRestockTypeReturn {
+		t.Errorf("RefundLineItem.RestockType returned %+v, expected %+v", lineItem.RestockType, RefundRestockTypeReturn)
+	}
+	expectedLocationID := int64(487838322)
+	if lineItem.LocationId != expectedLocationID {
+		t.Errorf("RefundLineItem.LocationId returned %+v, expected %+v", lineItem.LocationId, expectedLocationID)
+	}
+	expectedSubtotal := decimal.RequireFromString("195.66")
+	if lineItem.Subtotal == nil || !lineItem.Subtotal.Equals(expectedSubtotal) {
+		t.Errorf("RefundLineItem.Subtotal returned %+v, expected %+v", lineItem.Subtotal, expectedSubtotal)
+	}
+
+	// Check the refund transactions
+	if len(refund.Transactions) != 1 {
+		t.Fatalf("Refund.Transactions returned %d transactions, expected 1", len(refund.Transactions))
+	}
+	expectedAmount := decimal.RequireFromString("209.00")
+	if !refund.Transactions[0].Amount.Equals(expectedAmount) {
+		t.Errorf("Transaction.Amount returned %+v, expected %+v", refund.Transactions[0].Amount, expectedAmount)
+	}
+}
+
+func TestRefundList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders/450789469/refunds.json",
+		httpmock.NewBytesResponder(200, loadFixture("refunds.json")))
+
+	refunds, err := client.Refund.List(450789469, nil)
+	if err != nil {
+		t.Errorf("Refund.List returned error: %v", err)
+	}
+
+	if len(refunds) != 1 {
+		t.Fatalf("Refund.List returned %d refunds, expected 1", len(refunds))
+	}
+	refundTests(t, refunds[0])
+}
+
+func TestRefundGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/orders/450789469/refunds/509562969.json",
+		httpmock.NewBytesResponder(200, loadFixture("refund.json")))
+
+	refund, err := client.Refund.Get(450789469, 509562969, nil)
+	if err != nil {
+		t.Errorf("Refund.Get returned error: %v", err)
+	}
+
+	refundTests(t, *refund)
+}
+
+func TestRefundCalculate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/orders/450789469/refunds/calculate.json",
+		func(req *http.Request) (*http.Response, error) {
+			body := make(map[string]Refund)
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return httpmock.NewStringResponse(400, `{"errors": "bad request"}`), nil
+			}
+			refund := body["refund"]
+			if refund.Shipping == nil || !refund.Shipping.FullRefund || len(refund.RefundLineItems) != 1 {
+				return httpmock.NewStringResponse(422, `{"errors": "unexpected refund"}`), nil
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("refund_calculate.json")), nil
+		})
+
+	refund := Refund{
+		Shipping: &RefundShipping{FullRefund: true},
+		RefundLineItems: []RefundLineItem{
+			{LineItemId: 518995019, Quantity: 1, RestockType: RefundRestockTypeReturn, LocationId: 487838322},
+		},
+	}
+
+	calculated, err := client.Refund.Calculate(450789469, refund)
+	if err != nil {
+		t.Fatalf("Refund.Calculate returned error: %v", err)
+	}
+
+	expectedShipping := decimal.RequireFromString("5.00")
+	if calculated.Shipping == nil || !calculated.Shipping.MaximumRefundable.Equals(expectedShipping) {
+		t.Errorf("Refund.Shipping returned %+v, expected maximum refundable %+v", calculated.Shipping, expectedShipping)
+	}
+
+	if len(calculated.Transactions) != 1 {
+		t.Fatalf("Refund.Transactions returned %d transactions, expected 1", len(calculated.Transactions))
+	}
+	transaction := calculated.Transactions[0]
+	if transaction.Kind != TransactionKindSuggestedRefund {
+		t.Errorf("Transaction.Kind returned %+v, expected %+v", transaction.Kind, TransactionKindSuggestedRefund)
+	}
+	expectedAmount := decimal.RequireFromString("204.65")
+	if !transaction.Amount.Equals(expectedAmount) {
+		t.Errorf("Transaction.Amount returned %+v, expected %+v", transaction.Amount, expectedAmount)
+	}
+	expectedMaximum := decimal.RequireFromString("209.00")
+	if transaction.MaximumRefundable == nil || !transaction.MaximumRefundable.Equals(expectedMaximum) {
+		t.Errorf("Transaction.MaximumRefundable returned %+v, expected %+v", transaction.MaximumRefundable, expectedMaximum)
+	}
+}
+
+func TestRefundCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/orders/450789469/refunds.json",
+		httpmock.NewBytesResponder(201, loadFixture("refund.json")))
+
+	amount := decimal.RequireFromString("209.00")
+	parentID := int64(801038806)
+	refund := Refund{
+		Note:   "it broke during shipping",
+		Notify: true,
+		RefundLineItems: []RefundLineItem{
+			{LineItemId: 703073504, Quantity: 1, RestockType: RefundRestockTypeReturn, LocationId: 487838322},
+		},
+		Transactions: []Transaction{
+			{ParentID: &parentID, Amount: &amount, Kind: "refund", Gateway: "bogus"},
+		},
+	}
+
+	created, err := client.Refund.Create(450789469, refund)
+	if err != nil {
+		t.Errorf("Refund.Create returned error: %v", err)
+	}
+
+	refundTests(t, *created)
+}
@@ -0,0 +1,131 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Resource handles the List, Count, Get, Create, Update and Delete requests
+// that most Shopify REST resources share, as well as POST requests to their
+// action endpoints. It wraps and unwraps the JSON root
+// keys of the requests and responses, so that a resource only needs its model
+// type, base path and root keys. For example, the redirects endpoints are:
+//
+//	NewResource[Redirect](client, "admin/redirects", "redirect", "redirects")
+//
+// Resource can also be used for endpoints the library does not implement yet.
+type Resource[T any] struct {
+	client *Client
+
+	// Path of the collection without the .json suffix, e.g. "admin/redirects"
+	basePath string
+
+	// JSON root key of a single entity, e.g. "redirect"
+	key string
+
+	// JSON root key of a list of entities, e.g. "redirects"
+	listKey string
+}
+
+// NewResource returns a Resource for the entities of type T found at
+// basePath and wrapped in the key and listKey JSON root keys.
+func NewResource[T any](client *Client, basePath, key, listKey string) *Resource[T] {
+	return &Resource[T]{client: client, basePath: basePath, key: key, listKey: listKey}
+}
+
+// List entities
+func (r *Resource[T]) List(options interface{}) ([]T, error) {
+	path := fmt.Sprintf("%s.json", r.basePath)
+	var entities []T
+	err := r.createAndDo("GET", path, nil, options, r.listKey, &entities)
+	return entities, err
+}
+
+// Count entities
+func (r *Resource[T]) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", r.basePath)
+	return r.client.Count(path, options)
+}
+
+// Get individual entity
+func (r *Resource[T]) Get(id int64, options interface{}) (*T, error) {
+	path := fmt.Sprintf("%s/%d.json", r.basePath, id)
+	var entity *T
+	err := r.createAndDo("GET", path, nil, options, r.key, &entity)
+	return entity, err
+}
+
+// Create a new entity
+func (r *Resource[T]) Create(entity T) (*T, error) {
+	path := fmt.Sprintf("%s.json", r.basePath)
+	wrappedData := map[string]T{r.key: entity}
+	var created *T
+	err := r.createAndDo("POST", path, wrappedData, nil, r.key, &created)
+	return created, err
+}
+
+// Update an existing entity
+func (r *Resource[T]) Update(id int64, entity T) (*T, error) {
+	path := fmt.Sprintf("%s/%d.json", r.basePath, id)
+	wrappedData := map[string]T{r.key: entity}
+	var updated *T
+	err := r.createAndDo("PUT", path, wrappedData, nil, r.key, &updated)
+	return updated, err
+}
+
+// Delete an existing entity
+func (r *Resource[T]) Delete(id int64) error {
+	return r.client.Delete(fmt.Sprintf("%s/%d.json", r.basePath, id))
+}
+
+// Action performs a POST request to an action endpoint of an existing
+// entity, e.g. fulfillments/1/complete.json, and returns the entity from the
+// response.
+func (r *Resource[T]) Action(id int64, action string, data interface{}) (*T, error) {
+	return r.Do("POST", fmt.Sprintf("%d/%s", id, action), data, nil)
+}
+
+// CollectionAction performs a POST request to an action endpoint of the
+// collection, e.g. refunds/calculate.json, and returns the entity from the
+// response.
+func (r *Resource[T]) CollectionAction(action string, data interface{}) (*T, error) {
+	return r.Do("POST", action, data, nil)
+}
+
+// Do performs a request to a path relative to the base path, without the
+// .json suffix, e.g. "1/complete", and returns the entity from the response.
+// It covers the endpoints that don't fit the other methods.
+func (r *Resource[T]) Do(method, path string, data, options interface{}) (*T, error) {
+	path = fmt.Sprintf("%s/%s.json", r.basePath, path)
+	var entity *T
+	err := r.createAndDo(method, path, data, options, r.key, &entity)
+	return entity, err
+}
+
+// createAndDo performs the request and decodes the value found at the given
+// JSON root key of the response into v. A response without the key is a
+// ResponseDecodingError, rather than a nil entity without an error.
+func (r *Resource[T]) createAndDo(method, path string, data, options interface{}, key string, v interface{}) error {
+	req, err := r.client.NewRequest(method, path, data, options)
+	if err != nil {
+		return err
+	}
+
+	var body json.RawMessage
+	status, err := r.client.do(req, &body)
+	if err != nil {
+		return err
+	}
+
+	resource := make(map[string]json.RawMessage)
+	err = json.Unmarshal(body, &resource)
+	if err != nil {
+		return ResponseDecodingError{Body: body, Message: err.Error(), Status: status}
+	}
+
+	raw, ok := resource[key]
+	if !ok {
+		return ResponseDecodingError{Body: body, Message: fmt.Sprintf("missing %q in response", key), Status: status}
+	}
+	return json.Unmarshal(raw, v)
+}
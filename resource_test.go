@@ -0,0 +1,241 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+type widget struct {
+	ID   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+func widgets() *Resource[widget] {
+	return NewResource[widget](client, "admin/widgets", "widget", "widgets")
+}
+
+func TestResourceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/widgets.json",
+		httpmock.NewStringResponder(200, `{"widgets": [{"id":1},{"id":2}]}`))
+
+	list, err := widgets().List(nil)
+	if err != nil {
+		t.Errorf("Resource.List returned error: %v", err)
+	}
+
+	expected := []widget{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(list, expected) {
+		t.Errorf("Resource.List returned %+v, expected %+v", list, expected)
+	}
+}
+
+func TestResourceCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/widgets/count.json",
+		httpmock.NewStringResponder(200, `{"count": 3}`))
+
+	cnt, err := widgets().Count(nil)
+	if err != nil {
+		t.Errorf("Resource.Count returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("Resource.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestResourceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/widgets/1.json",
+		httpmock.NewStringResponder(200, `{"widget": {"id":1,"name":"foo"}}`))
+
+	w, err := widgets().Get(1, nil)
+	if err != nil {
+		t.Errorf("Resource.Get returned error: %v", err)
+	}
+
+	expected := &widget{ID: 1, Name: "foo"}
+	if !reflect.DeepEqual(w, expected) {
+		t.Errorf("Resource.Get returned %+v, expected %+v", w, expected)
+	}
+}
+
+func TestResourceGetError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/widgets/1.json",
+		httpmock.NewStringResponder(404, `{"errors": "Not Found"}`))
+
+	w, err := widgets().Get(1, nil)
+	if err == nil {
+		t.Errorf("Resource.Get expected error, got nil")
+	}
+
+	if w != nil {
+		t.Errorf("Resource.Get returned %+v, expected nil", w)
+	}
+}
+
+func TestResourceMissingKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/widgets/1.json",
+		httpmock.NewStringResponder(200, `{"id":1,"name":"foo"}`))
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/widgets/1/polish.json",
+		httpmock.NewStringResponder(200, `{"gadget": {"id":1}}`))
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/widgets.json",
+		httpmock.NewStringResponder(200, `{}`))
+
+	w, err := widgets().Get(1, nil)
+	decodingErr, ok := err.(ResponseDecodingError)
+	if !ok {
+		t.Errorf("Resource.Get returned error %v, expected a ResponseDecodingError", err)
+	} else if decodingErr.Status != 200 || string(decodingErr.Body) != `{"id":1,"name":"foo"}` {
+		t.Errorf("Resource.Get returned %+v, expected the status and body of the response", decodingErr)
+	}
+	if w != nil {
+		t.Errorf("Resource.Get returned %+v, expected nil", w)
+	}
+
+	w, err = widgets().Action(1, "polish", nil)
+	if _, ok := err.(ResponseDecodingError); !ok {
+		t.Errorf("Resource.Action returned error %v, expected a ResponseDecodingError", err)
+	}
+	if w != nil {
+		t.Errorf("Resource.Action returned %+v, expected nil", w)
+	}
+
+	_, err = widgets().List(nil)
+	if _, ok := err.(ResponseDecodingError); !ok {
+		t.Errorf("Resource.List returned error %v, expected a ResponseDecodingError", err)
+	}
+}
+
+func TestResourceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/widgets.json",
+		func(req *http.Request) (*http.Response, error) {
+			body := make(map[string]widget)
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return httpmock.NewStringResponse(400, `{"errors": "bad request"}`), nil
+			}
+			created := body["widget"]
+			created.ID = 1
+			return httpmock.NewJsonResponse(201, map[string]widget{"widget": created})
+		})
+
+	w, err := widgets().Create(widget{Name: "foo"})
+	if err != nil {
+		t.Errorf("Resource.Create returned error: %v", err)
+	}
+
+	expected := &widget{ID: 1, Name: "foo"}
+	if !reflect.DeepEqual(w, expected) {
+		t.Errorf("Resource.Create returned %+v, expected %+v", w, expected)
+	}
+}
+
+func TestResourceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/widgets/1.json",
+		httpmock.NewStringResponder(200, `{"widget": {"id":1,"name":"bar"}}`))
+
+	w, err := widgets().Update(1, widget{ID: 1, Name: "bar"})
+	if err != nil {
+		t.Errorf("Resource.Update returned error: %v", err)
+	}
+
+	expected := &widget{ID: 1, Name: "bar"}
+	if !reflect.DeepEqual(w, expected) {
+		t.Errorf("Resource.Update returned %+v, expected %+v", w, expected)
+	}
+}
+
+func TestResourceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", "https://fooshop.myshopify.com/admin/widgets/1.json",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := widgets().Delete(1)
+	if err != nil {
+		t.Errorf("Resource.Delete returned error: %v", err)
+	}
+}
+
+func TestResourceAction(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/widgets/1/polish.json",
+		httpmock.NewStringResponder(200, `{"widget": {"id":1,"name":"shiny"}}`))
+
+	w, err := widgets().Action(1, "polish", nil)
+	if err != nil {
+		t.Errorf("Resource.Action returned error: %v", err)
+	}
+
+	expected := &widget{ID: 1, Name: "shiny"}
+	if !reflect.DeepEqual(w, expected) {
+		t.Errorf("Resource.Action returned %+v, expected %+v", w, expected)
+	}
+}
+
+func TestResourceCollectionAction(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/widgets/calculate.json",
+		httpmock.NewStringResponder(200, `{"widget": {"name":"estimate"}}`))
+
+	w, err := widgets().CollectionAction("calculate", map[string]widget{"widget": {Name: "foo"}})
+	if err != nil {
+		t.Errorf("Resource.CollectionAction returned error: %v", err)
+	}
+
+	expected := &widget{Name: "estimate"}
+	if !reflect.DeepEqual(w, expected) {
+		t.Errorf("Resource.CollectionAction returned %+v, expected %+v", w, expected)
+	}
+}
+
+func TestResourceDo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/widgets/1/finish.json?glossy=true",
+		httpmock.NewStringResponder(200, `{"widget": {"id":1,"name":"finished"}}`))
+
+	options := struct {
+		Glossy bool `url:"glossy"`
+	}{true}
+
+	w, err := widgets().Do("PUT", "1/finish", nil, options)
+	if err != nil {
+		t.Errorf("Resource.Do returned error: %v", err)
+	}
+
+	expected := &widget{ID: 1, Name: "finished"}
+	if !reflect.DeepEqual(w, expected) {
+		t.Errorf("Resource.Do returned %+v, expected %+v", w, expected)
+	}
+}
@@ -1,9 +1,6 @@
 package goshopify
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
 
 const scriptTagsBasePath = "admin/script_tags"
 
@@ -13,10 +10,10 @@ const scriptTagsBasePath = "admin/script_tags"
 type ScriptTagService interface {
 	List(interface{}) ([]ScriptTag, error)
 	Count(interface{}) (int, error)
-	Get(int, interface{}) (*ScriptTag, error)
+	Get(int64, interface{}) (*ScriptTag, error)
 	Create(ScriptTag) (*ScriptTag, error)
 	Update(ScriptTag) (*ScriptTag, error)
-	Delete(int) error
+	Delete(int64) error
 }
 
 // ScriptTagServiceOp handles communication with the shop related methods of the
@@ -27,19 +24,20 @@ type ScriptTagServiceOp struct {
 
 // ScriptTag represents a Shopify ScriptTag.
 type ScriptTag struct {
-	CreatedAt    *time.Time `json:"created_at"`
-	Event        string     `json:"event"`
-	ID           int        `json:"id"`
-	Src          string     `json:"src"`
-	DisplayScope string     `json:"display_scope"`
-	UpdatedAt    *time.Time `json:"updated_at"`
+	CreatedAt         *time.Time `json:"created_at"`
+	Event             string     `json:"event"`
+	ID                int64      `json:"id"`
+	Src               string     `json:"src"`
+	DisplayScope      string     `json:"display_scope"`
+	UpdatedAt         *time.Time `json:"updated_at"`
+	AdminGraphqlAPIID string     `json:"admin_graphql_api_id,omitempty"`
 }
 
 // The options provided by Shopify.
 type ScriptTagOption struct {
 	Limit        int       `url:"limit,omitempty"`
 	Page         int       `url:"page,omitempty"`
-	SinceID      int       `url:"since_id,omitempty"`
+	SinceID      int64     `url:"since_id,omitempty"`
 	CreatedAtMin time.Time `url:"created_at_min,omitempty"`
 	CreatedAtMax time.Time `url:"created_at_max,omitempty"`
 	UpdatedAtMin time.Time `url:"updated_at_min,omitempty"`
@@ -50,57 +48,51 @@ type ScriptTagOption struct {
 
 // ScriptTagsResource represents the result from the admin/script_tags.json
 // endpoint.
+//
+// Deprecated: ScriptTagService.List returns the script tags directly.
 type ScriptTagsResource struct {
 	ScriptTags []ScriptTag `json:"script_tags"`
 }
 
 // ScriptTagResource represents the result from the
 // admin/script_tags/{#script_tag_id}.json endpoint.
+//
+// Deprecated: ScriptTagService returns and takes a ScriptTag directly.
 type ScriptTagResource struct {
 	ScriptTag *ScriptTag `json:"script_tag"`
 }
 
+// scriptTags returns the resource for the script tags endpoints
+func (s *ScriptTagServiceOp) scriptTags() *Resource[ScriptTag] {
+	return NewResource[ScriptTag](s.client, scriptTagsBasePath, "script_tag", "script_tags")
+}
+
 // List script tags
 func (s *ScriptTagServiceOp) List(options interface{}) ([]ScriptTag, error) {
-	path := fmt.Sprintf("%s.json", scriptTagsBasePath)
-	resource := &ScriptTagsResource{}
-	err := s.client.Get(path, resource, options)
-	return resource.ScriptTags, err
+	return s.scriptTags().List(options)
 }
 
 // Count script tags
 func (s *ScriptTagServiceOp) Count(options interface{}) (int, error) {
-	path := fmt.Sprintf("%s/count.json", scriptTagsBasePath)
-	return s.client.Count(path, options)
+	return s.scriptTags().Count(options)
 }
 
 // Get individual script tag
-func (s *ScriptTagServiceOp) Get(tagID int, options interface{}) (*ScriptTag, error) {
-	path := fmt.Sprintf("%s/%d.json", scriptTagsBasePath, tagID)
-	resource := &ScriptTagResource{}
-	err := s.client.Get(path, resource, options)
-	return resource.ScriptTag, err
+func (s *ScriptTagServiceOp) Get(tagID int64, options interface{}) (*ScriptTag, error) {
+	return s.scriptTags().Get(tagID, options)
 }
 
 // Create a new script tag
 func (s *ScriptTagServiceOp) Create(tag ScriptTag) (*ScriptTag, error) {
-	path := fmt.Sprintf("%s.json", scriptTagsBasePath)
-	wrappedData := ScriptTagResource{ScriptTag: &tag}
-	resource := &ScriptTagResource{}
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.ScriptTag, err
+	return s.scriptTags().Create(tag)
 }
 
 // Update an existing script tag
 func (s *ScriptTagServiceOp) Update(tag ScriptTag) (*ScriptTag, error) {
-	path := fmt.Sprintf("%s/%d.json", scriptTagsBasePath, tag.ID)
-	wrappedData := ScriptTagResource{ScriptTag: &tag}
-	resource := &ScriptTagResource{}
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.ScriptTag, err
+	return s.scriptTags().Update(tag.ID, tag)
 }
 
 // Delete an existing script tag
-func (s *ScriptTagServiceOp) Delete(tagID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", scriptTagsBasePath, tagID))
+func (s *ScriptTagServiceOp) Delete(tagID int64) error {
+	return s.scriptTags().Delete(tagID)
 }
@@ -4,7 +4,7 @@ import (
 	"testing"
 	"time"
 
-	"gopkg.in/jarcoal/httpmock.v1"
+	"github.com/jarcoal/httpmock"
 )
 
 func TestShopGet(t *testing.T) {
@@ -31,12 +31,13 @@ func TestShopGet(t *testing.T) {
 		expected interface{}
 		actual   interface{}
 	}{
-		{"ID", 690933842, shop.ID},
+		{"ID", int64(690933842), shop.ID},
 		{"ShopOwner", "Steve Jobs", shop.ShopOwner},
 		{"Address1", "1 Infinite Loop", shop.Address1},
 		{"Name", "Apple Computers", shop.Name},
 		{"Email", "steve@apple.com", shop.Email},
 		{"HasStorefront", true, shop.HasStorefront},
+		{"AdminGraphqlAPIID", NewGID(GIDShop, 690933842).String(), shop.AdminGraphqlAPIID},
 	}
 
 	for _, c := range cases {
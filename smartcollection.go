@@ -14,10 +14,11 @@ const smartCollectionsResourceName = "collections"
 type SmartCollectionService interface {
 	List(interface{}) ([]SmartCollection, error)
 	Count(interface{}) (int, error)
-	Get(int, interface{}) (*SmartCollection, error)
+	Get(int64, interface{}) (*SmartCollection, error)
 	Create(SmartCollection) (*SmartCollection, error)
 	Update(SmartCollection) (*SmartCollection, error)
-	Delete(int) error
+	Delete(int64) error
+	Order(int64, SmartCollectionOrderOptions) error
 
 	// MetafieldsService used for SmartCollection resource to communicate with Metafields resource
 	MetafieldsService
@@ -29,6 +30,26 @@ type SmartCollectionServiceOp struct {
 	client *Client
 }
 
+// Sort orders of a collection
+const (
+	CollectionSortOrderAlphaAsc    = "alpha-asc"
+	CollectionSortOrderAlphaDesc   = "alpha-desc"
+	CollectionSortOrderBestSelling = "best-selling"
+	CollectionSortOrderCreated     = "created"
+	CollectionSortOrderCreatedDesc = "created-desc"
+	CollectionSortOrderManual      = "manual"
+	CollectionSortOrderPriceAsc    = "price-asc"
+	CollectionSortOrderPriceDesc   = "price-desc"
+)
+
+// SmartCollectionOrderOptions are the options of a smart collection order
+// request. Products are the IDs of the products in their manual order, and
+// SortOrder optionally changes the sort order of the collection.
+type SmartCollectionOrderOptions struct {
+	Products  []int64 `url:"products[],omitempty"`
+	SortOrder string  `url:"sort_order,omitempty"`
+}
+
 type Rule struct {
 	Column    string `json:"column"`
 	Relation  string `json:"relation"`
@@ -37,110 +58,112 @@ type Rule struct {
 
 // SmartCollection represents a Shopify smart collection.
 type SmartCollection struct {
-	ID             int         `json:"id"`
-	Handle         string      `json:"handle"`
-	Title          string      `json:"title"`
-	UpdatedAt      *time.Time  `json:"updated_at"`
-	BodyHTML       string      `json:"body_html"`
-	SortOrder      string      `json:"sort_order"`
-	TemplateSuffix string      `json:"template_suffix"`
-	Image          Image       `json:"image"`
-	Published      bool        `json:"published"`
-	PublishedAt    *time.Time  `json:"published_at"`
-	PublishedScope string      `json:"published_scope"`
-	Rules          []Rule      `json:"rules"`
-	Disjunctive    bool        `json:"disjunctive"`
-	Metafields     []Metafield `json:"metafields,omitempty"`
+	ID                int64       `json:"id"`
+	Handle            string      `json:"handle"`
+	Title             string      `json:"title"`
+	UpdatedAt         *time.Time  `json:"updated_at"`
+	BodyHTML          string      `json:"body_html"`
+	SortOrder         string      `json:"sort_order"`
+	TemplateSuffix    string      `json:"template_suffix"`
+	Image             Image       `json:"image"`
+	Published         bool        `json:"published"`
+	PublishedAt       *time.Time  `json:"published_at"`
+	PublishedScope    string      `json:"published_scope"`
+	Rules             []Rule      `json:"rules"`
+	Disjunctive       bool        `json:"disjunctive"`
+	Metafields        []Metafield `json:"metafields,omitempty"`
+	AdminGraphqlAPIID string      `json:"admin_graphql_api_id,omitempty"`
 }
 
 // SmartCollectionResource represents the result from the smart_collections/X.json endpoint
+//
+// Deprecated: SmartCollectionService returns and takes a SmartCollection directly.
 type SmartCollectionResource struct {
 	Collection *SmartCollection `json:"smart_collection"`
 }
 
 // SmartCollectionsResource represents the result from the smart_collections.json endpoint
+//
+// Deprecated: SmartCollectionService.List returns the collections directly.
 type SmartCollectionsResource struct {
 	Collections []SmartCollection `json:"smart_collections"`
 }
 
+// smartCollections returns the resource for the smart collections endpoints
+func (s *SmartCollectionServiceOp) smartCollections() *Resource[SmartCollection] {
+	return NewResource[SmartCollection](s.client, smartCollectionsBasePath, "smart_collection", "smart_collections")
+}
+
 // List smart collections
 func (s *SmartCollectionServiceOp) List(options interface{}) ([]SmartCollection, error) {
-	path := fmt.Sprintf("%s.json", smartCollectionsBasePath)
-	resource := new(SmartCollectionsResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Collections, err
+	return s.smartCollections().List(options)
 }
 
 // Count smart collections
 func (s *SmartCollectionServiceOp) Count(options interface{}) (int, error) {
-	path := fmt.Sprintf("%s/count.json", smartCollectionsBasePath)
-	return s.client.Count(path, options)
+	return s.smartCollections().Count(options)
 }
 
 // Get individual smart collection
-func (s *SmartCollectionServiceOp) Get(collectionID int, options interface{}) (*SmartCollection, error) {
-	path := fmt.Sprintf("%s/%d.json", smartCollectionsBasePath, collectionID)
-	resource := new(SmartCollectionResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Collection, err
+func (s *SmartCollectionServiceOp) Get(collectionID int64, options interface{}) (*SmartCollection, error) {
+	return s.smartCollections().Get(collectionID, options)
 }
 
 // Create a new smart collection
 // See Image for the details of the Image creation for a collection.
 func (s *SmartCollectionServiceOp) Create(collection SmartCollection) (*SmartCollection, error) {
-	path := fmt.Sprintf("%s.json", smartCollectionsBasePath)
-	wrappedData := SmartCollectionResource{Collection: &collection}
-	resource := new(SmartCollectionResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Collection, err
+	return s.smartCollections().Create(collection)
 }
 
 // Update an existing smart collection
 func (s *SmartCollectionServiceOp) Update(collection SmartCollection) (*SmartCollection, error) {
-	path := fmt.Sprintf("%s/%d.json", smartCollectionsBasePath, collection.ID)
-	wrappedData := SmartCollectionResource{Collection: &collection}
-	resource := new(SmartCollectionResource)
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.Collection, err
+	return s.smartCollections().Update(collection.ID, collection)
 }
 
 // Delete an existing smart collection.
-func (s *SmartCollectionServiceOp) Delete(collectionID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", smartCollectionsBasePath, collectionID))
+func (s *SmartCollectionServiceOp) Delete(collectionID int64) error {
+	return s.smartCollections().Delete(collectionID)
+}
+
+// Order sets the manual order of the products of a smart collection or its
+// sort order
+func (s *SmartCollectionServiceOp) Order(collectionID int64, options SmartCollectionOrderOptions) error {
+	path := fmt.Sprintf("%s/%d/order.json", smartCollectionsBasePath, collectionID)
+	return s.client.CreateAndDo("PUT", path, nil, options, nil)
 }
 
 // List metafields for a smart collection
-func (s *SmartCollectionServiceOp) ListMetafields(smartCollectionID int, options interface{}) ([]Metafield, error) {
+func (s *SmartCollectionServiceOp) ListMetafields(smartCollectionID int64, options interface{}) ([]Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: smartCollectionsResourceName, resourceID: smartCollectionID}
 	return metafieldService.List(options)
 }
 
 // Count metafields for a smart collection
-func (s *SmartCollectionServiceOp) CountMetafields(smartCollectionID int, options interface{}) (int, error) {
+func (s *SmartCollectionServiceOp) CountMetafields(smartCollectionID int64, options interface{}) (int, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: smartCollectionsResourceName, resourceID: smartCollectionID}
 	return metafieldService.Count(options)
 }
 
 // Get individual metafield for a smart collection
-func (s *SmartCollectionServiceOp) GetMetafield(smartCollectionID int, metafieldID int, options interface{}) (*Metafield, error) {
+func (s *SmartCollectionServiceOp) GetMetafield(smartCollectionID int64, metafieldID int64, options interface{}) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: smartCollectionsResourceName, resourceID: smartCollectionID}
 	return metafieldService.Get(metafieldID, options)
 }
 
 // Create a new metafield for a smart collection
-func (s *SmartCollectionServiceOp) CreateMetafield(smartCollectionID int, metafield Metafield) (*Metafield, error) {
+func (s *SmartCollectionServiceOp) CreateMetafield(smartCollectionID int64, metafield Metafield) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: smartCollectionsResourceName, resourceID: smartCollectionID}
 	return metafieldService.Create(metafield)
 }
 
 // Update an existing metafield for a smart collection
-func (s *SmartCollectionServiceOp) UpdateMetafield(smartCollectionID int, metafield Metafield) (*Metafield, error) {
+func (s *SmartCollectionServiceOp) UpdateMetafield(smartCollectionID int64, metafield Metafield) (*Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: smartCollectionsResourceName, resourceID: smartCollectionID}
 	return metafieldService.Update(metafield)
 }
 
 // // Delete an existing metafield for a smart collection
-func (s *SmartCollectionServiceOp) DeleteMetafield(smartCollectionID int, metafieldID int) error {
+func (s *SmartCollectionServiceOp) DeleteMetafield(smartCollectionID int64, metafieldID int64) error {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: smartCollectionsResourceName, resourceID: smartCollectionID}
 	return metafieldService.Delete(metafieldID)
 }
@@ -5,7 +5,7 @@ import (
 	"testing"
 	"time"
 
-	httpmock "gopkg.in/jarcoal/httpmock.v1"
+	httpmock "github.com/jarcoal/httpmock"
 )
 
 func smartCollectionTests(t *testing.T, collection SmartCollection) {
@@ -15,7 +15,7 @@ func smartCollectionTests(t *testing.T, collection SmartCollection) {
 		expected interface{}
 		actual   interface{}
 	}{
-		{"ID", 30497275952, collection.ID},
+		{"ID", int64(30497275952), collection.ID},
 		{"Handle", "macbooks", collection.Handle},
 		{"Title", "Macbooks", collection.Title},
 		{"BodyHTML", "Macbook Body", collection.BodyHTML},
@@ -278,3 +278,21 @@ func TestSmartCollectionDeleteMetafield(t *testing.T) {
 		t.Errorf("SmartCollection.DeleteMetafield() returned error: %v", err)
 	}
 }
+
+func TestSmartCollectionOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", "https://fooshop.myshopify.com/admin/smart_collections/1/order.json?products%5B%5D=921728736&products%5B%5D=632910392&sort_order=manual",
+		bodyCheckingResponder(t, `null`, 200, []byte("{}")))
+
+	options := SmartCollectionOrderOptions{
+		Products:  []int64{921728736, 632910392},
+		SortOrder: CollectionSortOrderManual,
+	}
+
+	err := client.SmartCollection.Order(1, options)
+	if err != nil {
+		t.Errorf("SmartCollection.Order returned error: %v", err)
+	}
+}
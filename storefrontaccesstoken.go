@@ -1,9 +1,6 @@
 package goshopify
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
 
 const storefrontAccessTokensBasePath = "admin/storefront_access_tokens"
 
@@ -13,7 +10,7 @@ const storefrontAccessTokensBasePath = "admin/storefront_access_tokens"
 type StorefrontAccessTokenService interface {
 	List(interface{}) ([]StorefrontAccessToken, error)
 	Create(StorefrontAccessToken) (*StorefrontAccessToken, error)
-	Delete(int) error
+	Delete(int64) error
 }
 
 // StorefrontAccessTokenServiceOp handles communication with the storefront access token
@@ -24,7 +21,7 @@ type StorefrontAccessTokenServiceOp struct {
 
 // StorefrontAccessToken represents a Shopify storefront access token
 type StorefrontAccessToken struct {
-	ID                int        `json:"id,omitempty"`
+	ID                int64      `json:"id,omitempty"`
 	Title             string     `json:"title,omitempty"`
 	AccessToken       string     `json:"access_token,omitempty"`
 	AccessScope       string     `json:"access_scope,omitempty"`
@@ -33,33 +30,36 @@ type StorefrontAccessToken struct {
 }
 
 // StorefrontAccessTokenResource represents the result from the admin/storefront_access_tokens.json endpoint
+//
+// Deprecated: StorefrontAccessTokenService returns and takes a StorefrontAccessToken directly.
 type StorefrontAccessTokenResource struct {
 	StorefrontAccessToken *StorefrontAccessToken `json:"storefront_access_token"`
 }
 
 // StorefrontAccessTokensResource is the root object for a storefront access tokens get request.
+//
+// Deprecated: StorefrontAccessTokenService.List returns the tokens directly.
 type StorefrontAccessTokensResource struct {
 	StorefrontAccessTokens []StorefrontAccessToken `json:"storefront_access_tokens"`
 }
 
+// storefrontAccessTokens returns the resource for the storefront access
+// tokens endpoints
+func (s *StorefrontAccessTokenServiceOp) storefrontAccessTokens() *Resource[StorefrontAccessToken] {
+	return NewResource[StorefrontAccessToken](s.client, storefrontAccessTokensBasePath, "storefront_access_token", "storefront_access_tokens")
+}
+
 // List storefront access tokens
 func (s *StorefrontAccessTokenServiceOp) List(options interface{}) ([]StorefrontAccessToken, error) {
-	path := fmt.Sprintf("%s.json", storefrontAccessTokensBasePath)
-	resource := new(StorefrontAccessTokensResource)
-	err := s.client.Get(path, resource, options)
-	return resource.StorefrontAccessTokens, err
+	return s.storefrontAccessTokens().List(options)
 }
 
 // Create a new storefront access token
 func (s *StorefrontAccessTokenServiceOp) Create(storefrontAccessToken StorefrontAccessToken) (*StorefrontAccessToken, error) {
-	path := fmt.Sprintf("%s.json", storefrontAccessTokensBasePath)
-	wrappedData := StorefrontAccessTokenResource{StorefrontAccessToken: &storefrontAccessToken}
-	resource := new(StorefrontAccessTokenResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.StorefrontAccessToken, err
+	return s.storefrontAccessTokens().Create(storefrontAccessToken)
 }
 
 // Delete an existing storefront access token
-func (s *StorefrontAccessTokenServiceOp) Delete(ID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", storefrontAccessTokensBasePath, ID))
+func (s *StorefrontAccessTokenServiceOp) Delete(ID int64) error {
+	return s.storefrontAccessTokens().Delete(ID)
 }
@@ -4,7 +4,7 @@ import (
 	"testing"
 	"time"
 
-	httpmock "gopkg.in/jarcoal/httpmock.v1"
+	httpmock "github.com/jarcoal/httpmock"
 )
 
 func storefrontAccessTokenTests(t *testing.T, StorefrontAccessToken StorefrontAccessToken) {
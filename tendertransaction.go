@@ -0,0 +1,79 @@
+package goshopify
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const tenderTransactionsBasePath = "admin/tender_transactions"
+
+// TenderTransactionService is an interface for interfacing with the tender
+// transactions endpoints of the Shopify API. Unlike TransactionService, it
+// lists the transactions of all orders of the shop.
+// See: https://help.shopify.com/api/reference/tendertransaction
+type TenderTransactionService interface {
+	List(interface{}) ([]TenderTransaction, error)
+}
+
+// TenderTransactionServiceOp handles communication with the tender
+// transaction related methods of the Shopify API.
+type TenderTransactionServiceOp struct {
+	client *Client
+}
+
+// Payment methods of tender transactions
+const (
+	TenderTransactionPaymentMethodCreditCard = "credit_card"
+	TenderTransactionPaymentMethodCash       = "cash"
+	TenderTransactionPaymentMethodAndroidPay = "android_pay"
+	TenderTransactionPaymentMethodApplePay   = "apple_pay"
+	TenderTransactionPaymentMethodGooglePay  = "google_pay"
+	TenderTransactionPaymentMethodSamsungPay = "samsung_pay"
+	TenderTransactionPaymentMethodShopifyPay = "shopify_pay"
+	TenderTransactionPaymentMethodAmazon     = "amazon"
+	TenderTransactionPaymentMethodKlarna     = "klarna"
+	TenderTransactionPaymentMethodPaypal     = "paypal"
+	TenderTransactionPaymentMethodUnknown    = "unknown"
+	TenderTransactionPaymentMethodOther      = "other"
+)
+
+// Orders of the tender transactions to list
+const (
+	TenderTransactionOrderProcessedAtAsc  = "processed_at ASC"
+	TenderTransactionOrderProcessedAtDesc = "processed_at DESC"
+)
+
+// TenderTransaction represents a Shopify tender transaction, the money that
+// changed hands for an order. PaymentMethod is one of the
+// TenderTransactionPaymentMethod constants, and PaymentDetails is only set
+// for credit card payments.
+type TenderTransaction struct {
+	ID              int64            `json:"id,omitempty"`
+	OrderID         int64            `json:"order_id,omitempty"`
+	Amount          *decimal.Decimal `json:"amount,omitempty"`
+	Currency        string           `json:"currency,omitempty"`
+	UserID          int64            `json:"user_id,omitempty"`
+	Test            bool             `json:"test,omitempty"`
+	ProcessedAt     *time.Time       `json:"processed_at,omitempty"`
+	RemoteReference string           `json:"remote_reference,omitempty"`
+	PaymentMethod   string           `json:"payment_method,omitempty"`
+	PaymentDetails  *PaymentDetails  `json:"payment_details,omitempty"`
+}
+
+// TenderTransactionListOptions filters the tender transactions to list by
+// the time they were processed, and orders them by one of the
+// TenderTransactionOrder constants
+type TenderTransactionListOptions struct {
+	Limit          int       `url:"limit,omitempty"`
+	SinceID        int64     `url:"since_id,omitempty"`
+	ProcessedAtMin time.Time `url:"processed_at_min,omitempty"`
+	ProcessedAtMax time.Time `url:"processed_at_max,omitempty"`
+	ProcessedAt    time.Time `url:"processed_at,omitempty"`
+	Order          string    `url:"order,omitempty"`
+}
+
+// List gets the tender transactions of the shop.
+func (s *TenderTransactionServiceOp) List(options interface{}) ([]TenderTransaction, error) {
+	return NewResource[TenderTransaction](s.client, tenderTransactionsBasePath, "tender_transaction", "tender_transactions").List(options)
+}
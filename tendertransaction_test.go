@@ -0,0 +1,54 @@
+package goshopify
+
+import (
+	"testing"
+	"time"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func TestTenderTransactionList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/tender_transactions.json?order=processed_at+ASC&processed_at_max=2024-01-10T00%3A00%3A00Z&processed_at_min=2024-01-09T00%3A00%3A00Z",
+		httpmock.NewBytesResponder(200, loadFixture("tender_transactions.json")))
+
+	options := TenderTransactionListOptions{
+		ProcessedAtMin: time.Date(2024, time.January, 9, 0, 0, 0, 0, time.UTC),
+		ProcessedAtMax: time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC),
+		Order:          TenderTransactionOrderProcessedAtAsc,
+	}
+	transactions, err := client.TenderTransaction.List(options)
+	if err != nil {
+		t.Fatalf("TenderTransaction.List returned error: %v", err)
+	}
+
+	if len(transactions) != 2 {
+		t.Fatalf("TenderTransaction.List returned %d tender transactions, expected 2", len(transactions))
+	}
+
+	card := transactions[0]
+	if card.ID != 1011222896 || card.OrderID != 450789469 {
+		t.Errorf("TenderTransaction.List returned %+v, expected tender transaction 1011222896 of order 450789469", card)
+	}
+
+	expectedAmount := decimal.RequireFromString("250.94")
+	if card.Amount == nil || !card.Amount.Equals(expectedAmount) {
+		t.Errorf("TenderTransaction.Amount returned %v, expected %v", card.Amount, expectedAmount)
+	}
+
+	if card.PaymentMethod != TenderTransactionPaymentMethodCreditCard {
+		t.Errorf("TenderTransaction.PaymentMethod returned %q, expected %q", card.PaymentMethod, TenderTransactionPaymentMethodCreditCard)
+	}
+
+	if card.PaymentDetails == nil || card.PaymentDetails.CreditCardCompany != "Visa" {
+		t.Errorf("TenderTransaction.PaymentDetails returned %+v, expected Visa", card.PaymentDetails)
+	}
+
+	cash := transactions[1]
+	if cash.PaymentMethod != TenderTransactionPaymentMethodCash || cash.PaymentDetails != nil {
+		t.Errorf("TenderTransaction.List returned %+v, expected a cash tender transaction without payment details", cash)
+	}
+}
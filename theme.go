@@ -1,9 +1,6 @@
 package goshopify
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
 
 const themesBasePath = "admin/themes"
 
@@ -28,25 +25,25 @@ type ThemeServiceOp struct {
 
 // Theme represents a Shopify theme
 type Theme struct {
-	ID           int        `json:"id"`
-	Name         string     `json:"name"`
-	Previewable  bool       `json:"previewable"`
-	Processing   bool       `json:"processing"`
-	Role         string     `json:"role"`
-	ThemeStoreID int        `json:"theme_store_id"`
-	CreatedAt    *time.Time `json:"created_at"`
-	UpdatedAt    *time.Time `json:"updated_at"`
+	ID                int64      `json:"id"`
+	Name              string     `json:"name"`
+	Previewable       bool       `json:"previewable"`
+	Processing        bool       `json:"processing"`
+	Role              string     `json:"role"`
+	ThemeStoreID      int64      `json:"theme_store_id"`
+	CreatedAt         *time.Time `json:"created_at"`
+	UpdatedAt         *time.Time `json:"updated_at"`
+	AdminGraphqlAPIID string     `json:"admin_graphql_api_id,omitempty"`
 }
 
 // ThemesResource is the result from the themes.json endpoint
+//
+// Deprecated: ThemeService.List returns the themes directly.
 type ThemesResource struct {
 	Themes []Theme `json:"themes"`
 }
 
 // List all themes
 func (s *ThemeServiceOp) List(options interface{}) ([]Theme, error) {
-	path := fmt.Sprintf("%s.json", themesBasePath)
-	resource := new(ThemesResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Themes, err
+	return NewResource[Theme](s.client, themesBasePath, "theme", "themes").List(options)
 }
@@ -6,10 +6,10 @@ import "fmt"
 // the Shopify API.
 // See: https://help.shopify.com/api/reference/transaction
 type TransactionService interface {
-	List(int, interface{}) ([]Transaction, error)
-	Count(int, interface{}) (int, error)
-	Get(int, int, interface{}) (*Transaction, error)
-	Create(int, Transaction) (*Transaction, error)
+	List(int64, interface{}) ([]Transaction, error)
+	Count(int64, interface{}) (int, error)
+	Get(int64, int64, interface{}) (*Transaction, error)
+	Create(int64, Transaction) (*Transaction, error)
 }
 
 // TransactionServiceOp handles communication with the transaction related methods of the
@@ -19,42 +19,42 @@ type TransactionServiceOp struct {
 }
 
 // TransactionResource represents the result from the orders/X/transactions/Y.json endpoint
+//
+// Deprecated: TransactionService returns and takes a Transaction directly.
 type TransactionResource struct {
 	Transaction *Transaction `json:"transaction"`
 }
 
 // TransactionsResource represents the result from the orders/X/transactions.json endpoint
+//
+// Deprecated: TransactionService.List returns the transactions directly.
 type TransactionsResource struct {
 	Transactions []Transaction `json:"transactions"`
 }
 
+// transactions returns the resource for the transactions endpoints of an
+// order
+func (s *TransactionServiceOp) transactions(orderID int64) *Resource[Transaction] {
+	path := fmt.Sprintf("%s/%d/transactions", ordersBasePath, orderID)
+	return NewResource[Transaction](s.client, path, "transaction", "transactions")
+}
+
 // List transactions
-func (s *TransactionServiceOp) List(orderID int, options interface{}) ([]Transaction, error) {
-	path := fmt.Sprintf("%s/%d/transactions.json", ordersBasePath, orderID)
-	resource := new(TransactionsResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Transactions, err
+func (s *TransactionServiceOp) List(orderID int64, options interface{}) ([]Transaction, error) {
+	return s.transactions(orderID).List(options)
 }
 
 // Count transactions
-func (s *TransactionServiceOp) Count(orderID int, options interface{}) (int, error) {
-	path := fmt.Sprintf("%s/%d/transactions/count.json", ordersBasePath, orderID)
-	return s.client.Count(path, options)
+func (s *TransactionServiceOp) Count(orderID int64, options interface{}) (int, error) {
+	return s.transactions(orderID).Count(options)
 }
 
 // Get individual transaction
-func (s *TransactionServiceOp) Get(orderID int, transactionID int, options interface{}) (*Transaction, error) {
-	path := fmt.Sprintf("%s/%d/transactions/%d.json", ordersBasePath, orderID, transactionID)
-	resource := new(TransactionResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Transaction, err
+func (s *TransactionServiceOp) Get(orderID int64, transactionID int64, options interface{}) (*Transaction, error) {
+	return s.transactions(orderID).Get(transactionID, options)
 }
 
 // Create a new transaction
-func (s *TransactionServiceOp) Create(orderID int, transaction Transaction) (*Transaction, error) {
-	path := fmt.Sprintf("%s/%d/transactions.json", ordersBasePath, orderID)
-	wrappedData := TransactionResource{Transaction: &transaction}
-	resource := new(TransactionResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Transaction, err
+func (s *TransactionServiceOp) Create(orderID int64, transaction Transaction) (*Transaction, error) {
+	return s.transactions(orderID).Create(transaction)
 }
@@ -0,0 +1,106 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// UsageChargeService is an interface for interacting with the usage charges
+// endpoints of the Shopify API. Usage charges belong to a recurring
+// application charge with a capped amount.
+// See https://help.shopify.com/api/reference/billing/usagecharge
+type UsageChargeService interface {
+	Create(int64, UsageCharge) (*UsageCharge, error)
+	Get(int64, int64, interface{}) (*UsageCharge, error)
+	List(int64, interface{}) ([]UsageCharge, error)
+}
+
+// UsageChargeServiceOp handles communication with the usage charge related
+// methods of the Shopify API.
+type UsageChargeServiceOp struct {
+	client *Client
+}
+
+// UsageCharge represents a Shopify usage charge, which draws down the
+// capped amount of its recurring application charge.
+type UsageCharge struct {
+	ID               int64            `json:"id,omitempty"`
+	Description      string           `json:"description,omitempty"`
+	Price            *decimal.Decimal `json:"price,omitempty"`
+	Currency         string           `json:"currency,omitempty"`
+	BalanceUsed      *decimal.Decimal `json:"balance_used,omitempty"`
+	BalanceRemaining *decimal.Decimal `json:"balance_remaining,omitempty"`
+	RiskLevel        *decimal.Decimal `json:"risk_level,omitempty"`
+	CreatedAt        *time.Time       `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time       `json:"updated_at,omitempty"`
+}
+
+// UsageChargeCapError is returned by UsageChargeService.Create when the price
+// of the usage charge exceeds the balance remaining of the recurring
+// application charge. The merchant has to approve a higher capped amount,
+// see RecurringApplicationCharge.UpdateCappedAmountURL.
+type UsageChargeCapError struct {
+	ChargeID         int64
+	Price            decimal.Decimal
+	BalanceRemaining decimal.Decimal
+	CappedAmount     decimal.Decimal
+}
+
+func (e UsageChargeCapError) Error() string {
+	return fmt.Sprintf("usage charge of %s exceeds the balance remaining of %s of recurring application charge %d capped at %s",
+		e.Price, e.BalanceRemaining, e.ChargeID, e.CappedAmount)
+}
+
+// usageCharges returns the resource for the usage charges endpoints of a
+// recurring application charge
+func (r *UsageChargeServiceOp) usageCharges(chargeID int64) *Resource[UsageCharge] {
+	path := fmt.Sprintf("%s/%d/usage_charges", recurringApplicationChargesBasePath, chargeID)
+	return NewResource[UsageCharge](r.client, path, "usage_charge", "usage_charges")
+}
+
+// Create creates a new usage charge. It first gets the recurring application
+// charge and returns a UsageChargeCapError without creating the usage
+// charge if its price exceeds the balance remaining. If the charge has no
+// balance remaining, it is computed from the capped amount and the balance
+// used, and if neither is returned the usage charge isn't created either.
+func (r *UsageChargeServiceOp) Create(chargeID int64, usageCharge UsageCharge) (*UsageCharge, error) {
+	charge, err := r.client.RecurringApplicationCharge.Get(chargeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceRemaining := charge.BalanceRemaining
+	if balanceRemaining == nil && charge.CappedAmount != nil && charge.BalanceUsed != nil {
+		remaining := charge.CappedAmount.Sub(*charge.BalanceUsed)
+		balanceRemaining = &remaining
+	}
+	if balanceRemaining == nil {
+		return nil, fmt.Errorf("recurring application charge %d has no balance remaining or capped amount to check the usage charge against", chargeID)
+	}
+
+	if usageCharge.Price != nil && usageCharge.Price.GreaterThan(*balanceRemaining) {
+		capErr := UsageChargeCapError{
+			ChargeID:         chargeID,
+			Price:            *usageCharge.Price,
+			BalanceRemaining: *balanceRemaining,
+		}
+		if charge.CappedAmount != nil {
+			capErr.CappedAmount = *charge.CappedAmount
+		}
+		return nil, capErr
+	}
+
+	return r.usageCharges(chargeID).Create(usageCharge)
+}
+
+// Get gets individual usage charge.
+func (r *UsageChargeServiceOp) Get(chargeID, usageChargeID int64, options interface{}) (*UsageCharge, error) {
+	return r.usageCharges(chargeID).Get(usageChargeID, options)
+}
+
+// List gets all usage charges of a recurring application charge.
+func (r *UsageChargeServiceOp) List(chargeID int64, options interface{}) ([]UsageCharge, error) {
+	return r.usageCharges(chargeID).List(options)
+}
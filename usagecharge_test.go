@@ -0,0 +1,191 @@
+package goshopify
+
+import (
+	"errors"
+	"testing"
+
+	httpmock "github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func usageChargeTests(t *testing.T, usageCharge UsageCharge) {
+	// Check that the ID is assigned to the returned usage charge
+	expectedID := int64(1034618210)
+	if usageCharge.ID != expectedID {
+		t.Errorf("UsageCharge.ID returned %+v, expected %+v", usageCharge.ID, expectedID)
+	}
+
+	expectedPrice := decimal.RequireFromString("1.00")
+	if usageCharge.Price == nil || !usageCharge.Price.Equals(expectedPrice) {
+		t.Errorf("UsageCharge.Price returned %+v, expected %+v", usageCharge.Price, expectedPrice)
+	}
+
+	expectedBalanceRemaining := decimal.RequireFromString("89.0")
+	if usageCharge.BalanceRemaining == nil || !usageCharge.BalanceRemaining.Equals(expectedBalanceRemaining) {
+		t.Errorf("UsageCharge.BalanceRemaining returned %+v, expected %+v", usageCharge.BalanceRemaining, expectedBalanceRemaining)
+	}
+}
+
+// registerCappedCharge registers the recurring application charge the usage
+// charges of the tests belong to
+func registerCappedCharge() {
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/recurring_application_charges/455696195.json",
+		httpmock.NewStringResponder(200, `{"recurring_application_charge": {
+			"id": 455696195,
+			"name": "Super Mega Plan",
+			"status": "active",
+			"capped_amount": "100.00",
+			"balance_used": "90.00",
+			"balance_remaining": "10.00"
+		}}`))
+}
+
+func TestUsageChargeCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerCappedCharge()
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/recurring_application_charges/455696195/usage_charges.json",
+		bodyCheckingResponder(t,
+			`{"usage_charge": {"description": "Super Mega Plan 1000 emails", "price": "1"}}`,
+			201,
+			loadFixture("usage_charge.json")))
+
+	usageCharge := UsageCharge{
+		Description: "Super Mega Plan 1000 emails",
+		Price:       decimalPtr("1"),
+	}
+
+	created, err := client.UsageCharge.Create(455696195, usageCharge)
+	if err != nil {
+		t.Fatalf("UsageCharge.Create returned error: %v", err)
+	}
+
+	usageChargeTests(t, *created)
+}
+
+func TestUsageChargeCreateExceedsCap(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerCappedCharge()
+
+	usageCharge := UsageCharge{
+		Description: "Super Mega Plan 100000 emails",
+		Price:       decimalPtr("10.01"),
+	}
+
+	_, err := client.UsageCharge.Create(455696195, usageCharge)
+
+	var capErr UsageChargeCapError
+	if !errors.As(err, &capErr) {
+		t.Fatalf("UsageCharge.Create returned error %v, expected a UsageChargeCapError", err)
+	}
+
+	expected := UsageChargeCapError{
+		ChargeID:         455696195,
+		Price:            decimal.RequireFromString("10.01"),
+		BalanceRemaining: decimal.RequireFromString("10.00"),
+		CappedAmount:     decimal.RequireFromString("100.00"),
+	}
+	if capErr.ChargeID != expected.ChargeID || !capErr.Price.Equals(expected.Price) ||
+		!capErr.BalanceRemaining.Equals(expected.BalanceRemaining) || !capErr.CappedAmount.Equals(expected.CappedAmount) {
+		t.Errorf("UsageCharge.Create returned %+v, expected %+v", capErr, expected)
+	}
+
+	calls := httpmock.GetCallCountInfo()["POST https://fooshop.myshopify.com/admin/recurring_application_charges/455696195/usage_charges.json"]
+	if calls != 0 {
+		t.Errorf("UsageCharge.Create created the usage charge %d times, expected 0", calls)
+	}
+}
+
+func TestUsageChargeCreateComputesBalanceRemaining(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/recurring_application_charges/455696195.json",
+		httpmock.NewStringResponder(200, `{"recurring_application_charge": {
+			"id": 455696195,
+			"status": "active",
+			"capped_amount": "100.00",
+			"balance_used": "95.00"
+		}}`))
+
+	usageCharge := UsageCharge{
+		Description: "Super Mega Plan 10000 emails",
+		Price:       decimalPtr("10"),
+	}
+
+	_, err := client.UsageCharge.Create(455696195, usageCharge)
+
+	var capErr UsageChargeCapError
+	if !errors.As(err, &capErr) {
+		t.Fatalf("UsageCharge.Create returned error %v, expected a UsageChargeCapError", err)
+	}
+
+	expectedBalanceRemaining := decimal.RequireFromString("5.00")
+	if !capErr.BalanceRemaining.Equals(expectedBalanceRemaining) {
+		t.Errorf("UsageChargeCapError.BalanceRemaining returned %v, expected %v", capErr.BalanceRemaining, expectedBalanceRemaining)
+	}
+}
+
+func TestUsageChargeCreateWithoutCap(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/recurring_application_charges/455696195.json",
+		httpmock.NewStringResponder(200, `{"recurring_application_charge": {"id": 455696195, "status": "active"}}`))
+
+	usageCharge := UsageCharge{
+		Description: "Super Mega Plan 1000 emails",
+		Price:       decimalPtr("1"),
+	}
+
+	_, err := client.UsageCharge.Create(455696195, usageCharge)
+	if err == nil {
+		t.Errorf("UsageCharge.Create of a charge without a cap expected an error")
+	}
+
+	var capErr UsageChargeCapError
+	if errors.As(err, &capErr) {
+		t.Errorf("UsageCharge.Create returned %v, expected an error other than a UsageChargeCapError", err)
+	}
+
+	calls := httpmock.GetCallCountInfo()["POST https://fooshop.myshopify.com/admin/recurring_application_charges/455696195/usage_charges.json"]
+	if calls != 0 {
+		t.Errorf("UsageCharge.Create created the usage charge %d times, expected 0", calls)
+	}
+}
+
+func TestUsageChargeGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/recurring_application_charges/455696195/usage_charges/1034618210.json",
+		httpmock.NewBytesResponder(200, loadFixture("usage_charge.json")))
+
+	usageCharge, err := client.UsageCharge.Get(455696195, 1034618210, nil)
+	if err != nil {
+		t.Errorf("UsageCharge.Get returned error: %v", err)
+	}
+
+	usageChargeTests(t, *usageCharge)
+}
+
+func TestUsageChargeList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/recurring_application_charges/455696195/usage_charges.json",
+		httpmock.NewBytesResponder(200, loadFixture("usage_charges.json")))
+
+	usageCharges, err := client.UsageCharge.List(455696195, nil)
+	if err != nil {
+		t.Errorf("UsageCharge.List returned error: %v", err)
+	}
+
+	if len(usageCharges) != 2 {
+		t.Fatalf("UsageCharge.List returned %d usage charges, expected 2", len(usageCharges))
+	}
+	usageChargeTests(t, usageCharges[0])
+}
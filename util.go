@@ -30,7 +30,7 @@ func ShopBaseUrl(name string) string {
 }
 
 // Return the prefix for a metafield path
-func MetafieldPathPrefix(resource string, resourceID int) string {
+func MetafieldPathPrefix(resource string, resourceID int64) string {
 	var prefix string
 	if resource == "" {
 		prefix = fmt.Sprintf("admin/metafields")
@@ -40,8 +40,19 @@ func MetafieldPathPrefix(resource string, resourceID int) string {
 	return prefix
 }
 
+// Return the prefix for an event path
+func EventPathPrefix(resource string, resourceID int64) string {
+	var prefix string
+	if resource == "" {
+		prefix = fmt.Sprintf("admin/events")
+	} else {
+		prefix = fmt.Sprintf("admin/%s/%d/events", resource, resourceID)
+	}
+	return prefix
+}
+
 // Return the prefix for a fulfillment path
-func FulfillmentPathPrefix(resource string, resourceID int) string {
+func FulfillmentPathPrefix(resource string, resourceID int64) string {
 	var prefix string
 	if resource == "" {
 		prefix = fmt.Sprintf("admin/fulfillments")
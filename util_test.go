@@ -66,7 +66,7 @@ func TestShopBaseUrl(t *testing.T) {
 func TestMetafieldPathPrefix(t *testing.T) {
 	cases := []struct {
 		resource   string
-		resourceID int
+		resourceID int64
 		expected   string
 	}{
 		{"", 0, "admin/metafields"},
@@ -81,10 +81,28 @@ func TestMetafieldPathPrefix(t *testing.T) {
 	}
 }
 
+func TestEventPathPrefix(t *testing.T) {
+	cases := []struct {
+		resource   string
+		resourceID int64
+		expected   string
+	}{
+		{"", 0, "admin/events"},
+		{"products", 123, "admin/products/123/events"},
+	}
+
+	for _, c := range cases {
+		actual := EventPathPrefix(c.resource, c.resourceID)
+		if actual != c.expected {
+			t.Errorf("EventPathPrefix(%s, %d): expected %s, actual %s", c.resource, c.resourceID, c.expected, actual)
+		}
+	}
+}
+
 func TestFulfillmentPathPrefix(t *testing.T) {
 	cases := []struct {
 		resource   string
-		resourceID int
+		resourceID int64
 		expected   string
 	}{
 		{"", 0, "admin/fulfillments"},
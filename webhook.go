@@ -1,9 +1,6 @@
 package goshopify
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
 
 const webhooksBasePath = "admin/webhooks"
 
@@ -13,10 +10,10 @@ const webhooksBasePath = "admin/webhooks"
 type WebhookService interface {
 	List(interface{}) ([]Webhook, error)
 	Count(interface{}) (int, error)
-	Get(int, interface{}) (*Webhook, error)
+	Get(int64, interface{}) (*Webhook, error)
 	Create(Webhook) (*Webhook, error)
 	Update(Webhook) (*Webhook, error)
-	Delete(int) error
+	Delete(int64) error
 }
 
 // WebhookServiceOp handles communication with the webhook-related methods of
@@ -27,7 +24,7 @@ type WebhookServiceOp struct {
 
 // Webhook represents a Shopify webhook
 type Webhook struct {
-	ID                  int        `json:"id"`
+	ID                  int64      `json:"id"`
 	Address             string     `json:"address"`
 	Topic               string     `json:"topic"`
 	Format              string     `json:"format"`
@@ -35,6 +32,7 @@ type Webhook struct {
 	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
 	Fields              []string   `json:"fields"`
 	MetafieldNamespaces []string   `json:"metafield_namespaces"`
+	AdminGraphqlAPIID   string     `json:"admin_graphql_api_id,omitempty"`
 }
 
 // WebhookOptions can be used for filtering webhooks on a List request.
@@ -44,56 +42,50 @@ type WebhookOptions struct {
 }
 
 // WebhookResource represents the result from the admin/webhooks.json endpoint
+//
+// Deprecated: WebhookService returns and takes a Webhook directly.
 type WebhookResource struct {
 	Webhook *Webhook `json:"webhook"`
 }
 
 // WebhooksResource is the root object for a webhook get request.
+//
+// Deprecated: WebhookService.List returns the webhooks directly.
 type WebhooksResource struct {
 	Webhooks []Webhook `json:"webhooks"`
 }
 
+// webhooks returns the resource for the webhooks endpoints
+func (s *WebhookServiceOp) webhooks() *Resource[Webhook] {
+	return NewResource[Webhook](s.client, webhooksBasePath, "webhook", "webhooks")
+}
+
 // List webhooks
 func (s *WebhookServiceOp) List(options interface{}) ([]Webhook, error) {
-	path := fmt.Sprintf("%s.json", webhooksBasePath)
-	resource := new(WebhooksResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Webhooks, err
+	return s.webhooks().List(options)
 }
 
 // Count webhooks
 func (s *WebhookServiceOp) Count(options interface{}) (int, error) {
-	path := fmt.Sprintf("%s/count.json", webhooksBasePath)
-	return s.client.Count(path, options)
+	return s.webhooks().Count(options)
 }
 
 // Get individual webhook
-func (s *WebhookServiceOp) Get(webhookdID int, options interface{}) (*Webhook, error) {
-	path := fmt.Sprintf("%s/%d.json", webhooksBasePath, webhookdID)
-	resource := new(WebhookResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Webhook, err
+func (s *WebhookServiceOp) Get(webhookdID int64, options interface{}) (*Webhook, error) {
+	return s.webhooks().Get(webhookdID, options)
 }
 
 // Create a new webhook
 func (s *WebhookServiceOp) Create(webhook Webhook) (*Webhook, error) {
-	path := fmt.Sprintf("%s.json", webhooksBasePath)
-	wrappedData := WebhookResource{Webhook: &webhook}
-	resource := new(WebhookResource)
-	err := s.client.Post(path, wrappedData, resource)
-	return resource.Webhook, err
+	return s.webhooks().Create(webhook)
 }
 
 // Update an existing webhook.
 func (s *WebhookServiceOp) Update(webhook Webhook) (*Webhook, error) {
-	path := fmt.Sprintf("%s/%d.json", webhooksBasePath, webhook.ID)
-	wrappedData := WebhookResource{Webhook: &webhook}
-	resource := new(WebhookResource)
-	err := s.client.Put(path, wrappedData, resource)
-	return resource.Webhook, err
+	return s.webhooks().Update(webhook.ID, webhook)
 }
 
 // Delete an existing webhooks
-func (s *WebhookServiceOp) Delete(ID int) error {
-	return s.client.Delete(fmt.Sprintf("%s/%d.json", webhooksBasePath, ID))
+func (s *WebhookServiceOp) Delete(ID int64) error {
+	return s.webhooks().Delete(ID)
 }